@@ -0,0 +1,119 @@
+// Package exhaustive defines an Analyzer that reports non-exhaustive switch statements over gnum enums.
+//
+// A switch over a gnum.Enum value must either have a case for every enum declaration or a default case.
+// A switch can opt out with a `//gnum:nonexhaustive` comment on the line of the switch or the line above it.
+// Switches over gnum.FlagEnum values aren't checked, since flags are usually combined.
+package exhaustive
+
+import (
+	"github.com/joelboim/gnum/analysis/internal/definition"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"strings"
+)
+
+const (
+	doc = `check that switch statements over gnum enums are exhaustive
+
+The gnumexhaustive analyzer reports switch statements over a gnum.Enum value
+that neither have a case for every enum declaration nor a default case.
+Add a //gnum:nonexhaustive comment to the switch to opt out.`
+
+	nonExhaustiveDirective = "//gnum:nonexhaustive"
+)
+
+// Analyzer reports non-exhaustive switch statements over gnum enums.
+var Analyzer = &analysis.Analyzer{
+	Name:     "gnumexhaustive",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	// Files are visited before their switch statements, so directiveLines always belongs to the current file.
+	var directiveLines map[int]bool
+	inspect.Preorder([]ast.Node{(*ast.File)(nil), (*ast.SwitchStmt)(nil)}, func(node ast.Node) {
+		if file, ok := node.(*ast.File); ok {
+			directiveLines = getDirectiveLines(pass, file)
+			return
+		}
+
+		switchStmt := node.(*ast.SwitchStmt)
+		if switchStmt.Tag == nil {
+			return
+		}
+
+		line := pass.Fset.Position(switchStmt.Pos()).Line
+		if directiveLines[line] || directiveLines[line-1] {
+			return
+		}
+
+		checkSwitch(pass, switchStmt)
+	})
+
+	return nil, nil
+}
+
+// checkSwitch reports switchStmt if its tag is a gnum enum and it misses enum declarations without a default case.
+func checkSwitch(pass *analysis.Pass, switchStmt *ast.SwitchStmt) {
+	tagType := pass.TypesInfo.TypeOf(switchStmt.Tag)
+	enumDefinition, ok := definition.Resolve(tagType)
+	if !ok || enumDefinition.Flag {
+		return
+	}
+
+	covered := make(map[int]bool)
+	for _, stmt := range switchStmt.Body.List {
+		caseClause := stmt.(*ast.CaseClause)
+		if caseClause.List == nil {
+			return
+		}
+
+		for _, expr := range caseClause.List {
+			value := pass.TypesInfo.Types[expr].Value
+			if value == nil {
+				continue
+			}
+
+			if caseValue, exact := constant.Int64Val(constant.ToInt(value)); exact {
+				covered[int(caseValue)] = true
+			}
+		}
+	}
+
+	var missing []string
+	for _, member := range enumDefinition.Members {
+		if !covered[member.Value] {
+			missing = append(missing, member.Name)
+		}
+	}
+
+	if len(missing) > 0 {
+		pass.Reportf(
+			switchStmt.Pos(),
+			"missing cases in switch of gnum enum %s: %s",
+			types.TypeString(tagType, types.RelativeTo(pass.Pkg)),
+			strings.Join(missing, ", "))
+	}
+}
+
+// getDirectiveLines returns the lines of file that have a //gnum:nonexhaustive comment.
+func getDirectiveLines(pass *analysis.Pass, file *ast.File) map[int]bool {
+	directiveLines := make(map[int]bool)
+	for _, commentGroup := range file.Comments {
+		for _, comment := range commentGroup.List {
+			if strings.HasPrefix(comment.Text, nonExhaustiveDirective) {
+				directiveLines[pass.Fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+
+	return directiveLines
+}
@@ -0,0 +1,16 @@
+package exhaustive_test
+
+import (
+	"github.com/joelboim/gnum/analysis/exhaustive"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"testing"
+)
+
+func TestAnalyzer_OnSwitchStatements_ThenReportNonExhaustive(t *testing.T) {
+	// Arrange
+	testdata := analysistest.TestData()
+
+	// Act
+	// Assert
+	analysistest.Run(t, testdata, exhaustive.Analyzer, "a")
+}
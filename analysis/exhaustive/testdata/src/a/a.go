@@ -0,0 +1,79 @@
+package a
+
+import "github.com/joelboim/gnum"
+
+type (
+	Color = gnum.Enum[struct {
+		Red,
+		Blue color
+		Green color `gnum:"name=green,value=10"`
+	}]
+	color int
+)
+
+const (
+	Red Color = iota
+	Blue
+	Green Color = 10
+)
+
+type (
+	Permission = gnum.FlagEnum[struct {
+		Read,
+		Write permission
+	}]
+	permission int
+)
+
+const (
+	Read Permission = 1 << iota
+	Write
+)
+
+func exhaustive(c Color) {
+	switch c {
+	case Red, Blue:
+	case Green:
+	}
+}
+
+func withDefault(c Color) {
+	switch c {
+	case Red:
+	default:
+	}
+}
+
+func missing(c Color) {
+	switch c { // want "missing cases in switch of gnum enum Color: Blue, green"
+	case Red:
+	}
+}
+
+func missingWithLiteral(c Color) {
+	switch c { // want "missing cases in switch of gnum enum Color: Red"
+	case 1, 10:
+	}
+}
+
+func nonExhaustive(c Color) {
+	//gnum:nonexhaustive
+	switch c {
+	case Red:
+	}
+
+	switch c { //gnum:nonexhaustive
+	}
+}
+
+func flags(p Permission) {
+	switch p {
+	case Read:
+	}
+}
+
+func notEnum(i int) {
+	switch i {
+	case 1:
+	}
+}
@@ -0,0 +1,14 @@
+// The gnumcheck command runs the gnum analyzers, e.g., `go vet -vettool=$(which gnumcheck) ./...`.
+package main
+
+import (
+	"github.com/joelboim/gnum/analysis/exhaustive"
+	"github.com/joelboim/gnum/analysis/gnumcheck"
+	"golang.org/x/tools/go/analysis/multichecker"
+)
+
+func main() {
+	multichecker.Main(
+		gnumcheck.Analyzer,
+		exhaustive.Analyzer)
+}
@@ -0,0 +1,103 @@
+// Package gnumcheck defines an Analyzer that validates gnum enum definitions and their const blocks.
+//
+// It reports the mistakes gnum would otherwise panic on at runtime, such as malformed tags,
+// unknown or invalid config options, empty or duplicate names, duplicate values, aliases that collide
+// with a name or another alias, and consts of an enum type whose value
+// isn't part of the definition or whose name belongs to a declaration with a different value.
+package gnumcheck
+
+import (
+	"github.com/joelboim/gnum/analysis/internal/definition"
+	"go/ast"
+	"go/types"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const doc = `check gnum enum definitions and their const blocks
+
+The gnumcheck analyzer reports malformed gnum tags, unknown or invalid config options,
+duplicate enum names, values and aliases, and consts of a gnum enum type that don't match the enum definition.`
+
+// Analyzer validates gnum enum definitions and their const blocks.
+var Analyzer = &analysis.Analyzer{
+	Name:     "gnumcheck",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	var definitions []*definition.Definition
+	inspect.Preorder([]ast.Node{(*ast.TypeSpec)(nil)}, func(node ast.Node) {
+		typeSpec := node.(*ast.TypeSpec)
+		if !typeSpec.Assign.IsValid() {
+			return
+		}
+
+		typeName, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+		if !ok {
+			return
+		}
+
+		enumDefinition, ok := definition.Resolve(typeName.Type())
+		if !ok {
+			return
+		}
+
+		for _, problem := range enumDefinition.Problems {
+			pass.Reportf(problem.Pos, "%s", problem.Message)
+		}
+
+		definitions = append(definitions, enumDefinition)
+	})
+
+	for _, enumDefinition := range definitions {
+		checkConsts(pass, enumDefinition)
+	}
+
+	return nil, nil
+}
+
+// checkConsts reports the consts of the enumDefinition type declared in the package
+// whose value isn't part of the definition, or whose name matches a declaration with a different value.
+func checkConsts(pass *analysis.Pass, enumDefinition *definition.Definition) {
+	nameToMember := make(map[string]definition.Member, len(enumDefinition.Members))
+	for _, member := range enumDefinition.Members {
+		nameToMember[member.Field.Name()] = member
+	}
+
+	for _, member := range enumDefinition.Members {
+		nameToMember[member.Name] = member
+	}
+
+	for ident, object := range pass.TypesInfo.Defs {
+		enumConst, ok := object.(*types.Const)
+		if !ok || !types.Identical(enumConst.Type(), enumDefinition.Type) {
+			continue
+		}
+
+		value, ok := definition.ConstValue(enumConst)
+		if !ok || !enumDefinition.IsValid(value) {
+			pass.Reportf(
+				ident.Pos(),
+				"`%s` value %s isn't part of the enum declarations",
+				ident.Name,
+				enumConst.Val())
+			continue
+		}
+
+		if member, ok := nameToMember[ident.Name]; ok && member.Value != value {
+			pass.Reportf(
+				ident.Pos(),
+				"`%s` is %d but the `%s` enum declaration is %d",
+				ident.Name,
+				value,
+				member.Name,
+				member.Value)
+		}
+	}
+}
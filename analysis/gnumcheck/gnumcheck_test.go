@@ -0,0 +1,16 @@
+package gnumcheck_test
+
+import (
+	"github.com/joelboim/gnum/analysis/gnumcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"testing"
+)
+
+func TestAnalyzer_OnInvalidDefinitionsAndConsts_ThenReport(t *testing.T) {
+	// Arrange
+	testdata := analysistest.TestData()
+
+	// Act
+	// Assert
+	analysistest.Run(t, testdata, gnumcheck.Analyzer, "a")
+}
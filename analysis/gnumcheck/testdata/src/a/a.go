@@ -0,0 +1,79 @@
+package a
+
+import "github.com/joelboim/gnum"
+
+type (
+	Color = gnum.Enum[struct {
+		Red,
+		Blue color
+		Green  color `gnum:"value=10"`
+		Yellow color
+	}]
+	color int
+)
+
+const (
+	Red Color = iota
+	Blue
+	Green  Color = 10
+	Yellow Color = 12 // want "`Yellow` value 12 isn't part of the enum declarations"
+)
+
+const Cyan Color = Green + 10 // want "`Cyan` value 20 isn't part of the enum declarations"
+
+type (
+	Size = gnum.Enum[struct {
+		Small,
+		Large size
+	}]
+	size int
+)
+
+const (
+	Large Size = iota // want "`Large` is 0 but the `Large` enum declaration is 1"
+	Small             // want "`Small` is 1 but the `Small` enum declaration is 0"
+)
+
+type (
+	Malformed = gnum.Enum[struct {
+		A malformed `gnum:"nme=A"` // want "unknown key `nme` - `nme=A`"
+		B malformed `gnum:"name="` // want "enum name can't be empty - `name=`"
+		C malformed `gnum:"name=D"`
+		D malformed // want "duplicate enum name `D` of `D`, already declared by `C`"
+		E malformed `gnum:"value=2"` // want "`D` and `E` have the same value 2"
+	}]
+	malformed int
+)
+
+type (
+	Shape = gnum.Enum[struct {
+		_      struct{} `gnum:"step=0"` // want "invalid enum option `step` value `0` - `step=0`"
+		Square shape
+	}]
+	shape int
+)
+
+type (
+	Tone = gnum.Enum[struct {
+		_    struct{} `gnum:"bogus,json=text"` // want "unknown enum option `bogus` - `bogus,json=text`" "invalid enum option `json` value `text` - `bogus,json=text`"
+		Warm tone     `gnum:"alias=Cold|hot"`  // want "`Cold` alias of `Warm` is already an enum name"
+		Cold tone     `gnum:"alias=hot"`       // want "`Warm` and `Cold` have the same alias `hot`"
+	}]
+	tone int
+)
+
+type (
+	Permission = gnum.FlagEnum[struct {
+		None permission `gnum:"value=0"`
+		Read,
+		Write permission
+	}]
+	permission int
+)
+
+const (
+	Read Permission = 1 << iota
+	Write
+	Exec      // want "`Exec` value 4 isn't part of the enum declarations"
+	ReadWrite = Read | Write
+)
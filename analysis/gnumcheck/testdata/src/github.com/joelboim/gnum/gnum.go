@@ -0,0 +1,5 @@
+package gnum
+
+type Enum[T any] int
+
+type FlagEnum[T any] int
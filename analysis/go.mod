@@ -0,0 +1,16 @@
+module github.com/joelboim/gnum/analysis
+
+go 1.23.0
+
+require (
+	github.com/joelboim/gnum v0.0.0-00010101000000-000000000000
+	golang.org/x/tools v0.31.0
+)
+
+require (
+	golang.org/x/exp v0.0.0-20241009180824-f66d83c29e7c // indirect
+	golang.org/x/mod v0.24.0 // indirect
+	golang.org/x/sync v0.12.0 // indirect
+)
+
+replace github.com/joelboim/gnum => ../
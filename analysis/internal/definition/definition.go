@@ -0,0 +1,231 @@
+package definition
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/joelboim/gnum/infra"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"slices"
+)
+
+const (
+	gnumPackagePath     = "github.com/joelboim/gnum"
+	enumTypeName        = "Enum"
+	flagEnumTypeName    = "FlagEnum"
+	enumConfigFieldName = "_"
+)
+
+// Member is a resolved enum declaration.
+type Member struct {
+	Name    string
+	Value   int
+	Aliases []string
+	Field   *types.Var
+}
+
+// Problem is an invalid part of a definition, reported at Pos.
+type Problem struct {
+	Pos     token.Pos
+	Message string
+}
+
+// Definition is a gnum.Enum or gnum.FlagEnum type resolved statically,
+// following the same rules as the gnum package does at runtime.
+type Definition struct {
+	Type     *types.Named
+	Flag     bool
+	Members  []Member
+	Problems []Problem
+}
+
+// Resolve returns the Definition of t if it is a gnum.Enum[T] or gnum.FlagEnum[T] instance with a struct T.
+func Resolve(t types.Type) (*Definition, bool) {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.TypeArgs().Len() != 1 {
+		return nil, false
+	}
+
+	origin := named.Origin().Obj()
+	if origin.Pkg() == nil ||
+		origin.Pkg().Path() != gnumPackagePath ||
+		(origin.Name() != enumTypeName && origin.Name() != flagEnumTypeName) {
+		return nil, false
+	}
+
+	structType, ok := named.TypeArgs().At(0).Underlying().(*types.Struct)
+	if !ok {
+		return nil, false
+	}
+
+	definition := &Definition{
+		Type: named,
+		Flag: origin.Name() == flagEnumTypeName,
+	}
+	definition.resolveMembers(structType)
+
+	return definition, true
+}
+
+// MemberOf returns the member whose value is value.
+func (d *Definition) MemberOf(value int) (Member, bool) {
+	for _, member := range d.Members {
+		if member.Value == value {
+			return member, true
+		}
+	}
+
+	return Member{}, false
+}
+
+// IsValid reports whether value is a member value or, for flags, a combination of member values.
+func (d *Definition) IsValid(value int) bool {
+	if _, ok := d.MemberOf(value); ok || !d.Flag {
+		return ok
+	}
+
+	remaining := value
+	for _, member := range d.Members {
+		if member.Value > 0 && member.Value&(member.Value-1) == 0 {
+			remaining &^= member.Value
+		}
+	}
+
+	return remaining == 0
+}
+
+func (d *Definition) resolveMembers(structType *types.Struct) {
+	numbering := infra.Numbering{Shift: d.Flag}
+	var (
+		fields             []*types.Var
+		names              []string
+		aliases            [][]string
+		explicitEnumValues []*int
+	)
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		rawFieldTag, hasTag := reflect.StructTag(structType.Tag(i)).Lookup("gnum")
+		if field.Name() == enumConfigFieldName {
+			if hasTag {
+				d.resolveConfig(field, rawFieldTag, &numbering)
+			}
+
+			continue
+		}
+
+		name := field.Name()
+		var (
+			fieldAliases      []string
+			explicitEnumValue *int
+		)
+		if hasTag {
+			enumTag, err := infra.ParseEnumTag(rawFieldTag)
+			if err != nil {
+				d.report(field, "%s", err)
+			} else {
+				name = infra.GetPointerValue(enumTag.Name, name)
+				fieldAliases = enumTag.Aliases
+				explicitEnumValue = enumTag.Value
+			}
+		}
+
+		fields = append(fields, field)
+		names = append(names, name)
+		aliases = append(aliases, fieldAliases)
+		explicitEnumValues = append(explicitEnumValues, explicitEnumValue)
+	}
+
+	values, err := numbering.GetValues(explicitEnumValues)
+	if err != nil {
+		d.report(fields[len(values)], "%s - `%s`", err, names[len(values)])
+	}
+
+	nameToMember := make(map[string]Member, len(values))
+	valueToMember := make(map[int]Member, len(values))
+	for i, value := range values {
+		member := Member{Name: names[i], Value: value, Aliases: aliases[i], Field: fields[i]}
+		if duplicate, ok := nameToMember[member.Name]; ok {
+			d.report(
+				member.Field,
+				"duplicate enum name `%s` of `%s`, already declared by `%s`",
+				member.Name,
+				member.Field.Name(),
+				duplicate.Field.Name())
+			continue
+		}
+
+		if duplicate, ok := valueToMember[member.Value]; ok {
+			d.report(member.Field, "`%s` and `%s` have the same value %d", duplicate.Name, member.Name, member.Value)
+			continue
+		}
+
+		nameToMember[member.Name] = member
+		valueToMember[member.Value] = member
+		d.Members = append(d.Members, member)
+	}
+
+	d.resolveAliases(nameToMember)
+}
+
+// resolveAliases reports the aliases that are already an enum name or an alias of another member,
+// checking the members sorted by value like gnum does.
+func (d *Definition) resolveAliases(nameToMember map[string]Member) {
+	sortedMembers := slices.SortedFunc(slices.Values(d.Members), func(a, b Member) int {
+		return cmp.Compare(a.Value, b.Value)
+	})
+
+	aliasToMember := make(map[string]Member)
+	for _, member := range sortedMembers {
+		for _, alias := range member.Aliases {
+			if _, ok := nameToMember[alias]; ok {
+				d.report(member.Field, "`%s` alias of `%s` is already an enum name", alias, member.Name)
+				continue
+			}
+
+			if duplicate, ok := aliasToMember[alias]; ok {
+				d.report(member.Field, "`%s` and `%s` have the same alias `%s`", duplicate.Name, member.Name, alias)
+				continue
+			}
+
+			aliasToMember[alias] = member
+		}
+	}
+}
+
+// resolveConfig reports the invalid options of the enum config field tag and applies its numbering options.
+func (d *Definition) resolveConfig(field *types.Var, rawFieldTag string, numbering *infra.Numbering) {
+	options, err := infra.ParseEnumConfigTag(rawFieldTag)
+	if err != nil {
+		d.report(field, "%s", err)
+		return
+	}
+
+	for _, option := range options {
+		isValid, ok := infra.EnumConfigTagKeys[option.Key]
+		if !ok {
+			d.report(field, "unknown enum option `%s` - `%s`", option.Key, rawFieldTag)
+			continue
+		}
+
+		if !isValid(option.Value) {
+			d.report(field, "invalid enum option `%s` value `%s` - `%s`", option.Key, option.Value, rawFieldTag)
+			continue
+		}
+
+		if setNumbering, ok := infra.NumberingTagKeys[option.Key]; ok {
+			setNumbering(numbering, option.Value)
+		}
+	}
+}
+
+func (d *Definition) report(field *types.Var, format string, args ...any) {
+	d.Problems = append(d.Problems, Problem{Pos: field.Pos(), Message: fmt.Sprintf(format, args...)})
+}
+
+// ConstValue returns the int value of a constant declared with a gnum enum type.
+func ConstValue(enumConst *types.Const) (int, bool) {
+	value, exact := constant.Int64Val(constant.ToInt(enumConst.Val()))
+	return int(value), exact
+}
@@ -0,0 +1,201 @@
+package gnum
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// The "previous" benchmarks run the lookups of the revision before the front cache entries and the dense
+// lookups, a reflect.TypeOf keyed map of the metadata followed by a map of the values, kept here so both
+// implementations can be compared from this tree.
+// The "generated" benchmarks are what a code generator emits.
+
+var (
+	benchmarkName    string
+	benchmarkEnum    testAnimal
+	benchmarkIsValid bool
+)
+
+type benchmarkSparse = Enum[struct {
+	One     benchmarkSparse_ `gnum:"value=1"`
+	Hundred benchmarkSparse_ `gnum:"value=100"`
+	Million benchmarkSparse_ `gnum:"value=1000000"`
+}]
+
+type benchmarkSparse_ int
+
+// previousEnumCache is the enumCache of the previous revision, a copy on write map of the enum types
+// to their metadata.
+type previousEnumCache struct {
+	enumTypeToConfigMap atomic.Value
+}
+
+var previousCache = newPreviousEnumCache()
+
+func newPreviousEnumCache() *previousEnumCache {
+	cache := &previousEnumCache{}
+	cache.enumTypeToConfigMap.Store(make(map[reflect.Type]*enumMetadata))
+	return cache
+}
+
+func (c *previousEnumCache) Get(enumType reflect.Type) (config_ *enumMetadata, ok bool) {
+	config_, ok = c.enumTypeToConfigMap.Load().(map[reflect.Type]*enumMetadata)[enumType]
+	return
+}
+
+func (c *previousEnumCache) Set(enumType reflect.Type, config_ *enumMetadata) {
+	currentEnumTypeToConfigMap := c.enumTypeToConfigMap.Load().(map[reflect.Type]*enumMetadata)
+	newEnumTypeToConfigMap := make(map[reflect.Type]*enumMetadata, len(currentEnumTypeToConfigMap)+1)
+	for k, v := range currentEnumTypeToConfigMap {
+		newEnumTypeToConfigMap[k] = v
+	}
+
+	newEnumTypeToConfigMap[enumType] = config_
+	c.enumTypeToConfigMap.Store(newEnumTypeToConfigMap)
+}
+
+// previousGetConfig is the getConfig of the previous revision.
+func previousGetConfig[T any](e Enum[T]) *enumMetadata {
+	enumType := reflect.TypeOf(e)
+	if config_, ok := previousCache.Get(enumType); ok {
+		return config_
+	}
+
+	newConfig := e.getConfig()
+	previousCache.Set(enumType, newConfig)
+
+	return newConfig
+}
+
+// previousName is the Enum.Name of the previous revision.
+func previousName[T any](e Enum[T]) string {
+	name, ok := previousGetConfig(e).enumValueToEnumName[int(e)]
+	if !ok {
+		panic(fmt.Sprintf("%d isn't part of %T", e, e))
+	}
+
+	return name
+}
+
+// previousString is the Enum.String of the previous revision.
+func previousString[T any](e Enum[T]) string {
+	enumString, ok := previousGetConfig(e).enumValueToEnumString[int(e)]
+	if !ok {
+		panic(fmt.Sprintf("%d isn't part of %T", e, e))
+	}
+
+	return enumString
+}
+
+// previousParse is the Enum.Parse of the previous revision, without the global options.
+func previousParse[T any](e Enum[T], name string) (Enum[T], error) {
+	config := previousGetConfig(e)
+	value, ok := config.enumNameToEnumValue[name]
+	if !ok {
+		return -1, errors.New("`" + name + "`" + " isn't part of [" + strings.Join(config.sortedEnumNames, ", ") + "]")
+	}
+
+	return Enum[T](value), nil
+}
+
+func generatedAnimalName(e testAnimal) string {
+	switch e {
+	case chicken:
+		return "Chic\tken"
+	case dog:
+		return "Dog"
+	case cat:
+		return "Cat"
+	case cow:
+		return "Cow"
+	default:
+		return ""
+	}
+}
+
+func generatedAnimalParse(name string) (testAnimal, bool) {
+	switch name {
+	case "Chic\tken":
+		return chicken, true
+	case "Dog":
+		return dog, true
+	case "Cat":
+		return cat, true
+	case "Cow":
+		return cow, true
+	default:
+		return -1, false
+	}
+}
+
+func BenchmarkName(b *testing.B) {
+	b.Run("previous", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkName = previousName(testAnimal(i % 3))
+		}
+	})
+
+	b.Run("gnum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkName = testAnimal(i % 3).Name()
+		}
+	})
+
+	b.Run("gnum sparse", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkName = benchmarkSparse(100).Name()
+		}
+	})
+
+	b.Run("generated", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkName = generatedAnimalName(testAnimal(i % 3))
+		}
+	})
+}
+
+func BenchmarkString(b *testing.B) {
+	b.Run("previous", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkName = previousString(testAnimal(i % 3))
+		}
+	})
+
+	b.Run("gnum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkName = testAnimal(i % 3).String()
+		}
+	})
+}
+
+func BenchmarkIsValid(b *testing.B) {
+	b.Run("gnum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkIsValid = testAnimal(i % 5).IsValid()
+		}
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.Run("previous", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkEnum, _ = previousParse(testAnimal(0), "Cow")
+		}
+	})
+
+	b.Run("gnum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkEnum, _ = Parse[testAnimal]("Cow")
+		}
+	})
+
+	b.Run("generated", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchmarkEnum, _ = generatedAnimalParse("Cow")
+		}
+	})
+}
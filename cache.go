@@ -2,36 +2,158 @@ package gnum
 
 import (
 	"reflect"
+	"slices"
+	"sync"
 	"sync/atomic"
 )
 
+// enumCache is a copy on write mapping of enum types to their metadata.
+// Reads are a single atomic load, writes are compare and swap loops,
+// so concurrent first uses of different enums never lose each other's entry.
 type enumCache struct {
-	enumTypeToConfigMap atomic.Value
+	state atomic.Pointer[enumCacheState]
+}
+
+// enumCacheState is an immutable snapshot of the cache, generation is incremented on each Reset.
+// The first cached enum types are also kept in front, which is scanned before enumTypeToConfigMap
+// since comparing a few reflect.Type values is cheaper than hashing one.
+type enumCacheState struct {
+	generation          uint64
+	enumTypeToConfigMap map[reflect.Type]*enumMetadata
+	front               [enumCacheFrontSize]enumCacheEntry
+}
+
+// enumCacheFrontSize is the number of enum types kept in enumCacheState.front.
+const enumCacheFrontSize = 8
+
+type enumCacheEntry struct {
+	enumType reflect.Type
+	config   *enumMetadata
 }
 
 func newEnumCache() *enumCache {
-	enumTypeToConfigMap := atomic.Value{}
-	enumTypeToConfigMap.Store(make(map[reflect.Type]*enumMetadata, 0))
-	return &enumCache{
-		enumTypeToConfigMap: enumTypeToConfigMap,
+	cache := &enumCache{}
+	cache.state.Store(&enumCacheState{enumTypeToConfigMap: make(map[reflect.Type]*enumMetadata)})
+	return cache
+}
+
+// Lookup returns the cached enumMetadata of the enum type and the generation of the cache the lookup was made in,
+// the generation should be passed to LoadOrStore when the metadata is built on a cache miss.
+func (e *enumCache) Lookup(enumType reflect.Type) (config_ *enumMetadata, generation uint64, ok bool) {
+	state := e.state.Load()
+	for i := range state.front {
+		entry := &state.front[i]
+		if entry.enumType == nil {
+			break
+		}
+
+		if entry.enumType == enumType {
+			return entry.config, state.generation, true
+		}
+	}
+
+	config_, ok = state.enumTypeToConfigMap[enumType]
+	return config_, state.generation, ok
+}
+
+// LoadOrStore returns the cached enumMetadata of the enum type if another goroutine already stored it,
+// otherwise it stores config_ and returns it.
+// config_ isn't stored if the cache was reset since generation, as it may be built with stale options.
+func (e *enumCache) LoadOrStore(generation uint64, enumType reflect.Type, config_ *enumMetadata) *enumMetadata {
+	for {
+		currentState := e.state.Load()
+		if currentState.generation != generation {
+			return config_
+		}
+
+		if existingConfig, ok := currentState.enumTypeToConfigMap[enumType]; ok {
+			return existingConfig
+		}
+
+		if e.state.CompareAndSwap(currentState, currentState.with(enumType, config_)) {
+			return config_
+		}
 	}
 }
 
-// Get return the according config based on the enum definition type.
-func (e *enumCache) Get(enumType reflect.Type) (config_ *enumMetadata, ok bool) {
-	config_, ok = e.enumTypeToConfigMap.Load().(map[reflect.Type]*enumMetadata)[enumType]
-	return
+// Reset removes all the cached enumMetadata, so they are built again with the current options.
+func (e *enumCache) Reset() {
+	for {
+		currentState := e.state.Load()
+		newState := &enumCacheState{
+			generation:          currentState.generation + 1,
+			enumTypeToConfigMap: make(map[reflect.Type]*enumMetadata),
+		}
+		if e.state.CompareAndSwap(currentState, newState) {
+			return
+		}
+	}
+}
+
+// loadOrBuild returns the cached enumMetadata of the enum type,
+// or builds it with the enum type options and caches it on a cache miss.
+func (e *enumCache) loadOrBuild(
+	enum any,
+	build func(options ...Option) (*enumMetadata, error)) (*enumMetadata, error) {
+
+	enumType := reflect.TypeOf(enum)
+	config_, generation, ok := e.Lookup(enumType)
+	if ok {
+		return config_, nil
+	}
+
+	config_, err := build(enumOptions.Get(enumType)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.LoadOrStore(generation, enumType, config_), nil
 }
 
-// Set adds the existing enumTypeToConfigMap with the new enumMetadata and enum type
-// and sets the new mapping to the enumTypeToConfigMap atomic.Value.
-func (e *enumCache) Set(enumType reflect.Type, config_ *enumMetadata) {
-	currentEnumTypeToConfigMap := e.enumTypeToConfigMap.Load().(map[reflect.Type]*enumMetadata)
-	newEnumTypeToConfigMap := make(map[reflect.Type]*enumMetadata, len(currentEnumTypeToConfigMap)+1)
-	for k, v := range currentEnumTypeToConfigMap {
+// with returns a copy of the state with the enum type mapped to config_.
+func (s *enumCacheState) with(enumType reflect.Type, config_ *enumMetadata) *enumCacheState {
+	newEnumTypeToConfigMap := make(map[reflect.Type]*enumMetadata, len(s.enumTypeToConfigMap)+1)
+	for k, v := range s.enumTypeToConfigMap {
 		newEnumTypeToConfigMap[k] = v
 	}
 
 	newEnumTypeToConfigMap[enumType] = config_
-	e.enumTypeToConfigMap.Store(newEnumTypeToConfigMap)
+	newState := &enumCacheState{
+		generation:          s.generation,
+		enumTypeToConfigMap: newEnumTypeToConfigMap,
+		front:               s.front,
+	}
+	if len(s.enumTypeToConfigMap) < enumCacheFrontSize {
+		newState.front[len(s.enumTypeToConfigMap)] = enumCacheEntry{enumType: enumType, config: config_}
+	}
+
+	return newState
+}
+
+type enumOptionsRegistry struct {
+	lock                 sync.RWMutex
+	enumTypeToOptionsMap map[reflect.Type][]Option
+}
+
+func newEnumOptions() *enumOptionsRegistry {
+	return &enumOptionsRegistry{
+		enumTypeToOptionsMap: make(map[reflect.Type][]Option),
+	}
+}
+
+// Get returns the options configured for the enum type, in the order they were added.
+func (e *enumOptionsRegistry) Get(enumType reflect.Type) []Option {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	return e.enumTypeToOptionsMap[enumType]
+}
+
+// Add appends the options to the ones already configured for the enum type.
+// The options slice is never appended in place, so slices returned by Get stay intact.
+func (e *enumOptionsRegistry) Add(enumType reflect.Type, options ...Option) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.enumTypeToOptionsMap[enumType] = append(slices.Clip(e.enumTypeToOptionsMap[enumType]), options...)
 }
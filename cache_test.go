@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -17,15 +18,15 @@ type (
 	Shape = Enum[shapeDefinition]
 )
 
-func TestSet_OnEmptyCache_ThenReturnOneItem(t *testing.T) {
+func TestLoadOrStore_OnEmptyCache_ThenReturnOneItem(t *testing.T) {
 	// Arrange
 	cache := newEnumCache()
 	enumType := reflect.TypeOf(*new(Shape))
 	metadata := newEnumMetadata[shapeDefinition]()
 
 	// Act
-	cache.Set(enumType, metadata)
-	actualMetadata, ok := cache.Get(enumType)
+	cache.LoadOrStore(0, enumType, metadata)
+	actualMetadata, _, ok := cache.Lookup(enumType)
 	require.True(t, ok)
 
 	// Assert
@@ -52,7 +53,20 @@ func TestSet_OnEmptyCache_ThenReturnOneItem(t *testing.T) {
 				1: "Triangle",
 				2: "Circle",
 			},
-			joinedEnumNames: "Square, Triangle, Circle",
+			enumValueToEnumLabel: map[int]string{
+				0: "Square",
+				1: "Triangle",
+				2: "Circle",
+			},
+			typeName:                   "shape",
+			enumAliasToEnumValue:       map[string]int{},
+			enumValueToEnumDescription: map[int]string{0: "", 1: "", 2: ""},
+			sortedEnumDescriptions:     []string{"", "", ""},
+			sortedEnumLabels: []string{
+				"Square",
+				"Triangle",
+				"Circle",
+			},
 			sortedEnumNames: []string{
 				"Square",
 				"Triangle",
@@ -63,12 +77,13 @@ func TestSet_OnEmptyCache_ThenReturnOneItem(t *testing.T) {
 				"Triangle",
 				"Circle",
 			},
+			denseEnumIndexes: []int{0, 1, 2},
 			sortedEnumValues: []int{0, 1, 2},
 		},
 		actualMetadata)
 }
 
-func TestSet_OnInsertTwoItems_ThenTwoItemsExists(t *testing.T) {
+func TestLoadOrStore_OnInsertTwoItems_ThenTwoItemsExists(t *testing.T) {
 	// Arrange
 	type (
 		shape2           int
@@ -89,13 +104,13 @@ func TestSet_OnInsertTwoItems_ThenTwoItemsExists(t *testing.T) {
 	enumMetadata2 := newEnumMetadata[shapeDefinition2]()
 
 	// Act
-	cache.Set(enumType1, enumMetadata1)
-	cache.Set(enumType2, enumMetadata2)
+	cache.LoadOrStore(0, enumType1, enumMetadata1)
+	cache.LoadOrStore(0, enumType2, enumMetadata2)
 
-	actualMetadata1, ok := cache.Get(enumType1)
+	actualMetadata1, _, ok := cache.Lookup(enumType1)
 	require.True(t, ok)
 
-	actualMetadata2, ok := cache.Get(enumType2)
+	actualMetadata2, _, ok := cache.Lookup(enumType2)
 	require.True(t, ok)
 
 	// Assert
@@ -123,7 +138,20 @@ func TestSet_OnInsertTwoItems_ThenTwoItemsExists(t *testing.T) {
 					1: "Triangle",
 					2: "Circle",
 				},
-				joinedEnumNames: "Square, Triangle, Circle",
+				enumValueToEnumLabel: map[int]string{
+					0: "Square",
+					1: "Triangle",
+					2: "Circle",
+				},
+				typeName:                   "shape",
+				enumAliasToEnumValue:       map[string]int{},
+				enumValueToEnumDescription: map[int]string{0: "", 1: "", 2: ""},
+				sortedEnumDescriptions:     []string{"", "", ""},
+				sortedEnumLabels: []string{
+					"Square",
+					"Triangle",
+					"Circle",
+				},
 				sortedEnumNames: []string{
 					"Square",
 					"Triangle",
@@ -134,6 +162,7 @@ func TestSet_OnInsertTwoItems_ThenTwoItemsExists(t *testing.T) {
 					"Triangle",
 					"Circle",
 				},
+				denseEnumIndexes: []int{0, 1, 2},
 				sortedEnumValues: []int{0, 1, 2},
 			},
 			{
@@ -157,7 +186,20 @@ func TestSet_OnInsertTwoItems_ThenTwoItemsExists(t *testing.T) {
 					1: "Star",
 					2: "Hexagon",
 				},
-				joinedEnumNames: "Ellipsis, Star, Hexagon",
+				enumValueToEnumLabel: map[int]string{
+					0: "Ellipsis",
+					1: "Star",
+					2: "Hexagon",
+				},
+				typeName:                   "shape2",
+				enumAliasToEnumValue:       map[string]int{},
+				enumValueToEnumDescription: map[int]string{0: "", 1: "", 2: ""},
+				sortedEnumDescriptions:     []string{"", "", ""},
+				sortedEnumLabels: []string{
+					"Ellipsis",
+					"Star",
+					"Hexagon",
+				},
 				sortedEnumNames: []string{
 					"Ellipsis",
 					"Star",
@@ -168,6 +210,7 @@ func TestSet_OnInsertTwoItems_ThenTwoItemsExists(t *testing.T) {
 					"Star",
 					"Hexagon",
 				},
+				denseEnumIndexes: []int{0, 1, 2},
 				sortedEnumValues: []int{0, 1, 2},
 			},
 		},
@@ -177,7 +220,7 @@ func TestSet_OnInsertTwoItems_ThenTwoItemsExists(t *testing.T) {
 		})
 }
 
-func TestSet_OnSameDefinitionTwice_ThenTwoItemsExists(t *testing.T) {
+func TestLoadOrStore_OnSameDefinitionTwice_ThenTwoItemsExists(t *testing.T) {
 	// Arrange
 	type (
 		shapeDefinition2 struct {
@@ -197,13 +240,13 @@ func TestSet_OnSameDefinitionTwice_ThenTwoItemsExists(t *testing.T) {
 	enumMetadata2 := newEnumMetadata[shapeDefinition2]()
 
 	// Act
-	cache.Set(enumType1, enumMetadata1)
-	cache.Set(enumType2, enumMetadata2)
+	cache.LoadOrStore(0, enumType1, enumMetadata1)
+	cache.LoadOrStore(0, enumType2, enumMetadata2)
 
-	actualMetadata1, ok := cache.Get(enumType1)
+	actualMetadata1, _, ok := cache.Lookup(enumType1)
 	require.True(t, ok)
 
-	actualMetadata2, ok := cache.Get(enumType2)
+	actualMetadata2, _, ok := cache.Lookup(enumType2)
 	require.True(t, ok)
 
 	// Assert
@@ -231,7 +274,20 @@ func TestSet_OnSameDefinitionTwice_ThenTwoItemsExists(t *testing.T) {
 					1: "Triangle",
 					2: "Circle",
 				},
-				joinedEnumNames: "Square, Triangle, Circle",
+				enumValueToEnumLabel: map[int]string{
+					0: "Square",
+					1: "Triangle",
+					2: "Circle",
+				},
+				typeName:                   "shape",
+				enumAliasToEnumValue:       map[string]int{},
+				enumValueToEnumDescription: map[int]string{0: "", 1: "", 2: ""},
+				sortedEnumDescriptions:     []string{"", "", ""},
+				sortedEnumLabels: []string{
+					"Square",
+					"Triangle",
+					"Circle",
+				},
 				sortedEnumNames: []string{
 					"Square",
 					"Triangle",
@@ -242,6 +298,7 @@ func TestSet_OnSameDefinitionTwice_ThenTwoItemsExists(t *testing.T) {
 					"Triangle",
 					"Circle",
 				},
+				denseEnumIndexes: []int{0, 1, 2},
 				sortedEnumValues: []int{0, 1, 2},
 			},
 			{
@@ -265,7 +322,20 @@ func TestSet_OnSameDefinitionTwice_ThenTwoItemsExists(t *testing.T) {
 					1: "Triangle",
 					2: "Circle",
 				},
-				joinedEnumNames: "Square, Triangle, Circle",
+				enumValueToEnumLabel: map[int]string{
+					0: "Square",
+					1: "Triangle",
+					2: "Circle",
+				},
+				typeName:                   "shape",
+				enumAliasToEnumValue:       map[string]int{},
+				enumValueToEnumDescription: map[int]string{0: "", 1: "", 2: ""},
+				sortedEnumDescriptions:     []string{"", "", ""},
+				sortedEnumLabels: []string{
+					"Square",
+					"Triangle",
+					"Circle",
+				},
 				sortedEnumNames: []string{
 					"Square",
 					"Triangle",
@@ -276,6 +346,7 @@ func TestSet_OnSameDefinitionTwice_ThenTwoItemsExists(t *testing.T) {
 					"Triangle",
 					"Circle",
 				},
+				denseEnumIndexes: []int{0, 1, 2},
 				sortedEnumValues: []int{0, 1, 2},
 			},
 		},
@@ -285,24 +356,24 @@ func TestSet_OnSameDefinitionTwice_ThenTwoItemsExists(t *testing.T) {
 		})
 }
 
-func TestGet_OnEmptyCache_ThenFalse(t *testing.T) {
+func TestLookup_OnEmptyCache_ThenFalse(t *testing.T) {
 	// Arrange
 	cache := newEnumCache()
 
 	// Act
-	actualMetadata, ok := cache.Get(reflect.TypeOf(*new(Shape)))
+	actualMetadata, _, ok := cache.Lookup(reflect.TypeOf(*new(Shape)))
 	require.Nil(t, actualMetadata)
 
 	// Assert
 	assert.False(t, ok)
 }
 
-func TestGet_OnTypeNotExists_ThenFalse(t *testing.T) {
+func TestLookup_OnTypeNotExists_ThenFalse(t *testing.T) {
 	// Arrange
 	cache := newEnumCache()
 	enumType := reflect.TypeOf(*new(Shape))
 	enumMetadata := newEnumMetadata[shapeDefinition]()
-	cache.Set(enumType, enumMetadata)
+	cache.LoadOrStore(0, enumType, enumMetadata)
 
 	type (
 		Shape2 = Enum[struct {
@@ -313,9 +384,168 @@ func TestGet_OnTypeNotExists_ThenFalse(t *testing.T) {
 	)
 
 	// Act
-	actualMetadata, ok := cache.Get(reflect.TypeOf(*new(Shape2)))
+	actualMetadata, _, ok := cache.Lookup(reflect.TypeOf(*new(Shape2)))
 	require.Nil(t, actualMetadata)
 
 	// Assert
 	assert.False(t, ok)
 }
+
+func TestEnumOptionsAdd_OnMultipleCalls_ThenReturnOptionsInOrder(t *testing.T) {
+	// Arrange
+	registry := newEnumOptions()
+	enumType := reflect.TypeOf(*new(Shape))
+
+	// Act
+	registry.Add(enumType, CaseInsensitive(true))
+	registry.Add(enumType, SQLStorage(SQLStorageName), CaseInsensitive(false))
+	actualConfig := newEnumConfig(registry.Get(enumType))
+
+	// Assert
+	assert.Len(t, registry.Get(enumType), 3)
+	assert.False(t, actualConfig.caseInsensitive)
+	assert.Equal(t, SQLStorageName, actualConfig.sqlStorage)
+}
+
+func TestEnumOptionsGet_OnTypeNotExists_ThenReturnEmpty(t *testing.T) {
+	// Arrange
+	registry := newEnumOptions()
+
+	// Act
+	// Assert
+	assert.Empty(t, registry.Get(reflect.TypeOf(*new(Shape))))
+}
+
+func TestLoadOrStore_OnStoredType_ThenReturnStoredMetadata(t *testing.T) {
+	// Arrange
+	cache := newEnumCache()
+	enumType := reflect.TypeOf(*new(Shape))
+	_, generation, _ := cache.Lookup(enumType)
+	storedMetadata := newEnumMetadata[shapeDefinition]()
+	cache.LoadOrStore(generation, enumType, storedMetadata)
+
+	// Act
+	actualMetadata := cache.LoadOrStore(generation, enumType, newEnumMetadata[shapeDefinition]())
+
+	// Assert
+	assert.Same(t, storedMetadata, actualMetadata)
+}
+
+func TestLoadOrStore_OnResetSinceLookup_ThenNotStore(t *testing.T) {
+	// Arrange
+	cache := newEnumCache()
+	enumType := reflect.TypeOf(*new(Shape))
+	_, generation, _ := cache.Lookup(enumType)
+	cache.Reset()
+
+	// Act
+	cache.LoadOrStore(generation, enumType, newEnumMetadata[shapeDefinition]())
+	_, _, ok := cache.Lookup(enumType)
+
+	// Assert
+	assert.False(t, ok)
+}
+
+func TestLookup_OnMoreTypesThanFront_ThenReturnEachMetadata(t *testing.T) {
+	// Arrange
+	cache := newEnumCache()
+	var enumTypes []reflect.Type
+	for i := range enumCacheFrontSize + 2 {
+		enumTypes = append(enumTypes, reflect.ArrayOf(i, reflect.TypeOf(0)))
+	}
+
+	metadatas := make([]*enumMetadata, len(enumTypes))
+	for i, enumType := range enumTypes {
+		metadatas[i] = &enumMetadata{typeName: enumType.String()}
+		cache.LoadOrStore(0, enumType, metadatas[i])
+	}
+
+	// Act
+	// Assert
+	for i, enumType := range enumTypes {
+		actualMetadata, _, ok := cache.Lookup(enumType)
+		require.True(t, ok, enumType.String())
+		assert.Same(t, metadatas[i], actualMetadata, enumType.String())
+	}
+}
+
+func TestLoadOrStore_OnConcurrentSets_ThenKeepAllTypes(t *testing.T) {
+	// Arrange
+	cache := newEnumCache()
+	enumTypes := []reflect.Type{
+		reflect.TypeOf(*new(Shape)),
+		reflect.TypeOf(*new(testAnimal)),
+		reflect.TypeOf(*new(testColor)),
+		reflect.TypeOf(*new(testPermission)),
+		reflect.TypeOf(*new(testFruit)),
+	}
+	metadata := newEnumMetadata[shapeDefinition]()
+
+	// Act
+	var wg sync.WaitGroup
+	for _, enumType := range enumTypes {
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				cache.LoadOrStore(0, enumType, metadata)
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	// Assert
+	for _, enumType := range enumTypes {
+		_, _, ok := cache.Lookup(enumType)
+		assert.True(t, ok, enumType.String())
+	}
+}
+
+func TestGetConfig_OnConcurrentFirstUseAndReconfiguration_ThenNoRace(t *testing.T) {
+	// Arrange
+	type (
+		direction_ int
+		enum       = Enum[struct {
+			North,
+			East,
+			South,
+			West direction_
+		}]
+		flags = FlagEnum[struct {
+			Up,
+			Down direction_
+		}]
+	)
+
+	defer SetOptions(CaseInsensitive(false))
+
+	// Act
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, "South", enum(2).String())
+			assert.Equal(t, "Up|Down", flags(3).String())
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := Parse[enum]("West")
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			SetOptions(CaseInsensitive(i%2 == 0))
+			Configure[flags](SQLStorage(SQLStorageName))
+		}()
+	}
+
+	wg.Wait()
+	SetOptions(CaseInsensitive(true))
+
+	// Assert
+	actualEnum, err := Parse[enum]("west")
+	require.NoError(t, err)
+	assert.Equal(t, enum(3), actualEnum)
+}
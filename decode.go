@@ -0,0 +1,187 @@
+package gnum
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+)
+
+// decodeTagName is the struct tag that DecodeStruct reads the field keys from,
+// it's separate from the gnum tag of the enum declarations.
+const decodeTagName = "gnumenv"
+
+var (
+	registererType      = reflect.TypeFor[registerer]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// DecodeError is returned by DecodeStruct for every field whose value can't be parsed.
+type DecodeError struct {
+	// Field is the field path from the decoded struct, e.g., "Server.Color".
+	Field string
+	// Key is the key the value was looked up by.
+	Key string
+	// Value is the invalid value.
+	Value string
+	// Err is the parsing error, usually a *ParseError.
+	Err error
+}
+
+// Error returns the field path and key along with the parsing error.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("invalid `%s` field of `%s` key: %s", e.Field, e.Key, e.Err)
+}
+
+// Unwrap returns the parsing error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// FromEnv returns the T named by the key environment variable, or def if the variable is unset or empty.
+func FromEnv[T Enumer[T]](key string, def T) (T, error) {
+	name, ok := os.LookupEnv(key)
+	if !ok || name == "" {
+		return def, nil
+	}
+
+	enum, err := Parse[T](name)
+	if err != nil {
+		return def, fmt.Errorf("invalid `%s` environment variable: %w", key, err)
+	}
+
+	return enum, nil
+}
+
+// DecodeStruct sets every enum field of the struct dst points to by the value lookup returns for the field key,
+// e.g., `gnum.DecodeStruct(&config, os.LookupEnv)`. Fields whose key isn't found or has an empty value are left as is.
+// Enum, FlagEnum and Null fields are decoded, as well as pointers to them, which are allocated when their key is found.
+//
+// The key is the field `gnumenv` tag, or the field name if there is no tag, and a "-" tag skips the field.
+// Nested structs are decoded as well, prefixing their keys with the struct field tag if there is one,
+// e.g., a Color field tagged "COLOR" of a Server struct field tagged "SERVER_" is looked up by "SERVER_COLOR".
+// A nil pointer to a nested struct is only allocated if one of its fields is set, and a nested struct of a type
+// that is already being decoded, e.g., the `Next *Node` field of a Node, is skipped so recursive types terminate.
+//
+// All the invalid values are returned as *DecodeError joined together,
+// along with an error for each tagged field that isn't an enum or a struct.
+func DecodeStruct(dst any, lookup func(string) (string, bool)) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("can't decode into `%T`, expected a non-nil pointer to a struct", dst)
+	}
+
+	var errs []error
+	decodeStruct(value.Elem(), nil, "", "", lookup, &errs)
+	return errors.Join(errs...)
+}
+
+// decodeStruct decodes the fields of value and returns whether any of them was set,
+// parents are the struct types value is nested in.
+func decodeStruct(
+	value reflect.Value,
+	parents []reflect.Type,
+	path string,
+	keyPrefix string,
+	lookup func(string) (string, bool),
+	errs *[]error) bool {
+
+	parents = append(parents, value.Type())
+	isSet := false
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		tag, hasTag := field.Tag.Lookup(decodeTagName)
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		switch {
+		case isDecodedEnum(fieldType):
+			key := keyPrefix + field.Name
+			if hasTag {
+				key = keyPrefix + tag
+			}
+
+			isSet = decodeField(value.Field(i), fieldPath, key, lookup, errs) || isSet
+		// Structs that unmarshal themselves, e.g., time.Time, aren't made of enum fields.
+		case fieldType.Kind() == reflect.Struct && !reflect.PointerTo(fieldType).Implements(textUnmarshalerType):
+			if slices.Contains(parents, fieldType) {
+				continue
+			}
+
+			isSet = decodeNestedStruct(value.Field(i), parents, fieldPath, keyPrefix+tag, lookup, errs) || isSet
+		case hasTag:
+			*errs = append(*errs, fmt.Errorf("can't decode `%s` field of type `%s`, it isn't an enum", fieldPath, field.Type))
+		}
+	}
+
+	return isSet
+}
+
+// decodeNestedStruct decodes a struct or a pointer to a struct field,
+// a nil pointer is set to a new struct only if any of its fields was set.
+func decodeNestedStruct(
+	value reflect.Value,
+	parents []reflect.Type,
+	path string,
+	keyPrefix string,
+	lookup func(string) (string, bool),
+	errs *[]error) bool {
+
+	if value.Kind() != reflect.Pointer {
+		return decodeStruct(value, parents, path, keyPrefix, lookup, errs)
+	}
+
+	if !value.IsNil() {
+		return decodeStruct(value.Elem(), parents, path, keyPrefix, lookup, errs)
+	}
+
+	newValue := reflect.New(value.Type().Elem())
+	if !decodeStruct(newValue.Elem(), parents, path, keyPrefix, lookup, errs) {
+		return false
+	}
+
+	value.Set(newValue)
+	return true
+}
+
+// decodeField sets an enum or a pointer to an enum field by the value of key, and returns whether it was set.
+func decodeField(value reflect.Value, path string, key string, lookup func(string) (string, bool), errs *[]error) bool {
+	text, ok := lookup(key)
+	if !ok || text == "" {
+		return false
+	}
+
+	target := value.Addr()
+	if value.Kind() == reflect.Pointer {
+		target = reflect.New(value.Type().Elem())
+	}
+
+	if err := target.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text)); err != nil {
+		*errs = append(*errs, &DecodeError{Field: path, Key: key, Value: text, Err: err})
+		return false
+	}
+
+	if value.Kind() == reflect.Pointer {
+		value.Set(target)
+	}
+
+	return true
+}
+
+// isDecodedEnum reports whether fieldType is an Enum, a FlagEnum or a Null.
+func isDecodedEnum(fieldType reflect.Type) bool {
+	return fieldType.Implements(registererType) && reflect.PointerTo(fieldType).Implements(textUnmarshalerType)
+}
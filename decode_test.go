@@ -0,0 +1,201 @@
+package gnum
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type (
+	testDecodeServer struct {
+		Fruit testFruit `gnumenv:"FRUIT"`
+		Shape *testShape
+	}
+
+	testDecodeConfig struct {
+		Fruit      testFruit `gnumenv:"FRUIT"`
+		Permission testPermission
+		Shape      testShape         `gnumenv:"-"`
+		Server     testDecodeServer  `gnumenv:"SERVER_"`
+		Backup     *testDecodeServer `gnumenv:"BACKUP_"`
+		Spare      *testDecodeServer `gnumenv:"SPARE_"`
+		MaybeFruit Null[testFruit]   `gnumenv:"MAYBE_FRUIT"`
+		MaybeShape *Null[testShape]  `gnumenv:"MAYBE_SHAPE"`
+		Name       string
+		unexported testFruit
+	}
+
+	testDecodeNode struct {
+		Fruit testFruit `gnumenv:"FRUIT"`
+		Next  *testDecodeNode
+	}
+)
+
+func testLookup(values map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := values[key]
+		return value, ok
+	}
+}
+
+func TestFromEnv_OnSetVariable_ThenReturnEnum(t *testing.T) {
+	// Arrange
+	t.Setenv("GNUM_FRUIT", "Cherry")
+
+	// Act
+	fruit, err := FromEnv("GNUM_FRUIT", apple)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, cherry, fruit)
+}
+
+func TestFromEnv_OnUnsetOrEmptyVariable_ThenReturnDefault(t *testing.T) {
+	// Arrange
+	t.Setenv("GNUM_EMPTY_FRUIT", "")
+
+	// Act
+	unsetFruit, unsetErr := FromEnv("GNUM_UNSET_FRUIT", banana)
+	emptyFruit, emptyErr := FromEnv("GNUM_EMPTY_FRUIT", banana)
+
+	// Assert
+	assert.NoError(t, unsetErr)
+	assert.NoError(t, emptyErr)
+	assert.Equal(t, banana, unsetFruit)
+	assert.Equal(t, banana, emptyFruit)
+}
+
+func TestFromEnv_OnInvalidVariable_ThenReturnErrorAndDefault(t *testing.T) {
+	// Arrange
+	t.Setenv("GNUM_FRUIT", "Banan")
+
+	// Act
+	fruit, err := FromEnv("GNUM_FRUIT", apple)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownName)
+	assert.EqualError(t, err,
+		"invalid `GNUM_FRUIT` environment variable: `Banan` isn't part of [Apple, Banana, Cherry], did you mean `Banana`?")
+	assert.Equal(t, apple, fruit)
+}
+
+func TestDecodeStruct_OnValidValues_ThenSetEnumFields(t *testing.T) {
+	// Arrange
+	config := testDecodeConfig{Shape: circle}
+	lookup := testLookup(map[string]string{
+		"FRUIT":        "Banana",
+		"Permission":   "Read|Write",
+		"Shape":        "Square",
+		"SERVER_FRUIT": "Cherry",
+		"BACKUP_FRUIT": "Apple",
+		"BACKUP_Shape": "Triangle",
+		"MAYBE_FRUIT":  "Banana",
+		"MAYBE_SHAPE":  "Circle",
+		"unexported":   "Cherry",
+	})
+
+	// Act
+	err := DecodeStruct(&config, lookup)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, banana, config.Fruit)
+	assert.Equal(t, read|write, config.Permission)
+	assert.Equal(t, circle, config.Shape)
+	assert.Equal(t, cherry, config.Server.Fruit)
+	assert.Nil(t, config.Server.Shape)
+	require.NotNil(t, config.Backup)
+	assert.Equal(t, apple, config.Backup.Fruit)
+	require.NotNil(t, config.Backup.Shape)
+	assert.Equal(t, triangle, *config.Backup.Shape)
+	assert.Nil(t, config.Spare)
+	assert.Equal(t, NewNull(banana), config.MaybeFruit)
+	require.NotNil(t, config.MaybeShape)
+	assert.Equal(t, NewNull(circle), *config.MaybeShape)
+	assert.Equal(t, apple, config.unexported)
+}
+
+func TestDecodeStruct_OnMissingOrEmptyValues_ThenKeepFields(t *testing.T) {
+	// Arrange
+	config := testDecodeConfig{Fruit: cherry, Server: testDecodeServer{Fruit: banana}}
+
+	// Act
+	err := DecodeStruct(&config, testLookup(map[string]string{"FRUIT": ""}))
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, cherry, config.Fruit)
+	assert.Equal(t, banana, config.Server.Fruit)
+}
+
+func TestDecodeStruct_OnInvalidValues_ThenReturnAllErrorsWithFieldPaths(t *testing.T) {
+	// Arrange
+	var config testDecodeConfig
+	lookup := testLookup(map[string]string{
+		"FRUIT":        "Banan",
+		"SERVER_FRUIT": "Kiwi",
+	})
+
+	// Act
+	err := DecodeStruct(&config, lookup)
+
+	// Assert
+	assert.EqualError(t, err, "invalid `Fruit` field of `FRUIT` key: `Banan` isn't part of [Apple, Banana, Cherry], did you mean `Banana`?\n"+
+		"invalid `Server.Fruit` field of `SERVER_FRUIT` key: `Kiwi` isn't part of [Apple, Banana, Cherry]")
+	assert.ErrorIs(t, err, ErrUnknownName)
+	var decodeErr *DecodeError
+	require.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, "Fruit", decodeErr.Field)
+	assert.Equal(t, "Banan", decodeErr.Value)
+}
+
+func TestDecodeStruct_OnTaggedNonEnumField_ThenReturnError(t *testing.T) {
+	// Arrange
+	var config struct {
+		Fruit testFruit `gnumenv:"FRUIT"`
+		Name  string    `gnumenv:"NAME"`
+	}
+
+	// Act
+	err := DecodeStruct(&config, testLookup(map[string]string{"FRUIT": "Cherry", "NAME": "basket"}))
+
+	// Assert
+	assert.EqualError(t, err, "can't decode `Name` field of type `string`, it isn't an enum")
+	assert.Equal(t, cherry, config.Fruit)
+}
+
+func TestDecodeStruct_OnRecursiveStruct_ThenSkipRecursiveFields(t *testing.T) {
+	// Arrange
+	var node testDecodeNode
+
+	// Act
+	err := DecodeStruct(&node, testLookup(map[string]string{"FRUIT": "Cherry"}))
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, testDecodeNode{Fruit: cherry}, node)
+}
+
+func TestDecodeStruct_OnRecursiveStructAndEmptyLookup_ThenReturnNil(t *testing.T) {
+	// Arrange
+	var node testDecodeNode
+
+	// Act
+	err := DecodeStruct(&node, testLookup(nil))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, node.Next)
+}
+
+func TestDecodeStruct_OnNonStructPointer_ThenReturnError(t *testing.T) {
+	// Arrange
+	var config testDecodeConfig
+
+	// Act
+	err := DecodeStruct(config, testLookup(nil))
+
+	// Assert
+	assert.EqualError(t, err, "can't decode into `gnum.testDecodeConfig`, expected a non-nil pointer to a struct")
+}
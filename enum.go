@@ -1,9 +1,9 @@
 package gnum
 
 import (
-	"errors"
 	"fmt"
-	"reflect"
+	"iter"
+	"slices"
 	"strings"
 )
 
@@ -11,37 +11,123 @@ const enumValueNotExistsErrorFormat = "`%d` isn't part of `%T` mapping"
 
 var cache = newEnumCache()
 
+// flagSeparator separates the flag names of a combined FlagEnum value.
+const flagSeparator = "|"
+
 // Enum uses T struct definition for it's mapping of enum name to value.
 type Enum[T any] int
 
+// All returns an iterator over all Enum[T] declarations sorted by the enum values.
+func (e Enum[T]) All() iter.Seq[Enum[T]] {
+	return func(yield func(Enum[T]) bool) {
+		for _, value := range Enum[T](0).getConfig().sortedEnumValues {
+			if !yield(Enum[T](value)) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all Enum[T] declarations in reverse order of the enum values.
+func (e Enum[T]) Backward() iter.Seq[Enum[T]] {
+	return func(yield func(Enum[T]) bool) {
+		values := Enum[T](0).getConfig().sortedEnumValues
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(Enum[T](values[i])) {
+				return
+			}
+		}
+	}
+}
+
+// Description returns the Enum[T] description declared with the desc tag,
+// or an empty string if there isn't one.
+func (e Enum[T]) Description() string {
+	config := e.getConfig()
+	index, ok := config.index(int(e))
+	if !ok {
+		return ""
+	}
+
+	return config.sortedEnumDescriptions[index]
+}
+
+// Descriptions returns a copy of all the Enum[T] descriptions sorted by the enum values.
+func (e Enum[T]) Descriptions() []string {
+	return slices.Clone(e.getConfig().sortedEnumDescriptions)
+}
+
 // Enums returns a list of all Enum[T] declarations mapped to T
 func (e Enum[T]) Enums() []Enum[T] {
-	var values []Enum[T]
-	for _, value := range e.getConfig().sortedEnumValues {
+	sortedEnumValues := e.getConfig().sortedEnumValues
+	values := make([]Enum[T], 0, len(sortedEnumValues))
+	for _, value := range sortedEnumValues {
 		values = append(values, Enum[T](value))
 	}
 
 	return values
 }
 
+// IsValid reports whether e is part of the T mapping.
+func (e Enum[T]) IsValid() bool {
+	_, ok := e.getConfig().index(int(e))
+	return ok
+}
+
+// Label returns the Enum[T] display label declared with the label tag, defaults to the Enum[T] name.
+func (e Enum[T]) Label() string {
+	config := e.getConfig()
+	index, ok := config.index(int(e))
+	if !ok {
+		return e.Name()
+	}
+
+	return config.sortedEnumLabels[index]
+}
+
+// Labels returns a copy of all the Enum[T] display labels sorted by the enum values.
+func (e Enum[T]) Labels() []string {
+	return slices.Clone(e.getConfig().sortedEnumLabels)
+}
+
 // Name returns the Enum[T] programmatic string representation.
+// A value that isn't part of the T mapping is returned as "type(value)",
+// unless StrictString(true) is set, in which case Name panics.
 func (e Enum[T]) Name() string {
-	name, ok := e.getConfig().enumValueToEnumName[int(e)]
+	config := e.getConfig()
+	if index, ok := config.index(int(e)); ok {
+		return config.sortedEnumNames[index]
+	}
+
+	_, err := e.TryName()
+	return config.unknownString(int(e), err)
+}
+
+// TryName returns the Enum[T] programmatic string representation,
+// or an error if e isn't part of the T mapping.
+func (e Enum[T]) TryName() (string, error) {
+	config := e.getConfig()
+	index, ok := config.index(int(e))
 	if !ok {
-		panic(fmt.Sprintf(enumValueNotExistsErrorFormat, e, e))
+		return "", fmt.Errorf(enumValueNotExistsErrorFormat, e, e)
 	}
 
-	return name
+	return config.sortedEnumNames[index], nil
 }
 
-// Names returns all the Enum[T] programmatic string representations sorted by the enum values.
+// Names returns a copy of all the Enum[T] programmatic string representations sorted by the enum values.
 func (e Enum[T]) Names() []string {
-	return e.getConfig().sortedEnumNames
+	return slices.Clone(e.getConfig().sortedEnumNames)
 }
 
 // MarshalText implements the TextMarshaler interface for T.
 func (e Enum[T]) MarshalText() ([]byte, error) {
-	return []byte(e.Name()), nil
+	name, err := e.TryName()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(name), nil
 }
 
 // UnmarshalText implements the TextUnmarshaler interface for T.
@@ -55,65 +141,355 @@ func (e *Enum[T]) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// Pairs returns an iterator over all Enum[T] names and declarations sorted by the enum values.
+func (e Enum[T]) Pairs() iter.Seq2[string, Enum[T]] {
+	return func(yield func(string, Enum[T]) bool) {
+		config := Enum[T](0).getConfig()
+		for i, value := range config.sortedEnumValues {
+			if !yield(config.sortedEnumNames[i], Enum[T](value)) {
+				return
+			}
+		}
+	}
+}
+
 // Parse tries to parse an enum name based on the underline enum name to enum value mapping.
 // If CaseInsensitive(true) is set, Parse will use the lowered case name to value mapping instead.
 func (e Enum[T]) Parse(name string) (Enum[T], error) {
-	config := e.getConfig()
-
-	if globalConfig.parseCallback != nil {
-		name = globalConfig.parseCallback(name)
-	}
-
-	var (
-		value int
-		ok    bool
-	)
-	if globalConfig.caseInsensitive {
-		value, ok = config.enumNameLoweredToEnumValue[strings.ToLower(name)]
-	} else {
-		value, ok = config.enumNameToEnumValue[name]
-	}
-
-	if !ok {
-		return -1, errors.New("`" + name + "`" + " isn't part of [" + config.joinedEnumNames + "]")
+	value, err := e.getConfig().parse(name)
+	if err != nil {
+		return -1, err
 	}
 
 	return Enum[T](value), nil
 }
 
 // String returns the string representation of an Enum[T] value.
+// A value that isn't part of the T mapping is returned as "type(value)",
+// unless StrictString(true) is set, in which case String panics.
 func (e Enum[T]) String() string {
-	enumString, ok := e.getConfig().enumValueToEnumString[int(e)]
+	config := e.getConfig()
+	if index, ok := config.index(int(e)); ok {
+		return config.sortedEnumStrings[index]
+	}
+
+	_, err := e.TryString()
+	return config.unknownString(int(e), err)
+}
+
+// TryString returns the string representation of an Enum[T] value,
+// or an error if e isn't part of the T mapping.
+func (e Enum[T]) TryString() (string, error) {
+	config := e.getConfig()
+	index, ok := config.index(int(e))
 	if !ok {
-		panic(fmt.Sprintf(enumValueNotExistsErrorFormat, e, e))
+		return "", fmt.Errorf(enumValueNotExistsErrorFormat, e, e)
 	}
 
-	return enumString
+	return config.sortedEnumStrings[index], nil
 }
 
-// Strings returns all the Enum[T] string representations sorted by the enum values.
+// Strings returns a copy of all the Enum[T] string representations sorted by the enum values.
 func (e Enum[T]) Strings() []string {
-	return e.getConfig().sortedEnumStrings
+	return slices.Clone(e.getConfig().sortedEnumStrings)
 }
 
 // Type returns the underline T type.
 func (e Enum[T]) Type() string {
-	return reflect.TypeOf(*new(T)).Field(0).Type.Name()
+	return e.getConfig().typeName
 }
 
-// Values returns all the Enum[T] int representations sorted by the enum values.
+// Values returns a copy of all the Enum[T] int representations sorted by the enum values.
 func (e Enum[T]) Values() []int {
-	return e.getConfig().sortedEnumValues
+	return slices.Clone(e.getConfig().sortedEnumValues)
+}
+
+// NameList returns a read-only view of all the Enum[T] names sorted by the enum values, without copying them.
+func (e Enum[T]) NameList() List[string] {
+	return List[string]{items: e.getConfig().sortedEnumNames}
+}
+
+// StringList returns a read-only view of all the Enum[T] strings sorted by the enum values, without copying them.
+func (e Enum[T]) StringList() List[string] {
+	return List[string]{items: e.getConfig().sortedEnumStrings}
+}
+
+// ValueList returns a read-only view of all the Enum[T] ints sorted by the enum values, without copying them.
+func (e Enum[T]) ValueList() List[int] {
+	return List[int]{items: e.getConfig().sortedEnumValues}
 }
 
 func (e Enum[T]) getConfig() *enumMetadata {
-	enumType := reflect.TypeOf(e)
-	if config_, ok := cache.Get(enumType); ok {
-		return config_
+	return mustEnumMetadata(cache.loadOrBuild(Enum[T](0), tryNewEnumMetadata[T]))
+}
+
+// indexOf returns the index of value in the Enum[T] values sorted by value, or false if it isn't one of them.
+func (e Enum[T]) indexOf(value int) (int, bool) {
+	return e.getConfig().index(value)
+}
+
+// formatUnknown returns the "Type(value)" representation String uses for a value that isn't part of Enum[T].
+func (e Enum[T]) formatUnknown(value int) string {
+	return e.getConfig().formatUnknown(value)
+}
+
+// register builds and caches the Enum[T] metadata, returning the definition problems instead of panicking.
+func (e Enum[T]) register() error {
+	_, err := cache.loadOrBuild(Enum[T](0), tryNewEnumMetadata[T])
+	return err
+}
+
+// FlagEnum uses T struct definition for it's mapping of flag name to value.
+// Unlike Enum, each field is given the next power of two (1, 2, 4, ...)
+// so the values can be combined as a bit mask, e.g., `Read | Write`.
+type FlagEnum[T any] int
+
+// All returns an iterator over all FlagEnum[T] declarations sorted by the enum values.
+func (f FlagEnum[T]) All() iter.Seq[FlagEnum[T]] {
+	return func(yield func(FlagEnum[T]) bool) {
+		for _, value := range FlagEnum[T](0).getConfig().sortedEnumValues {
+			if !yield(FlagEnum[T](value)) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all FlagEnum[T] declarations in reverse order of the enum values.
+func (f FlagEnum[T]) Backward() iter.Seq[FlagEnum[T]] {
+	return func(yield func(FlagEnum[T]) bool) {
+		values := FlagEnum[T](0).getConfig().sortedEnumValues
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(FlagEnum[T](values[i])) {
+				return
+			}
+		}
+	}
+}
+
+// Description returns the FlagEnum[T] description declared with the desc tag,
+// or an empty string if there isn't one or f is a combination of flags.
+func (f FlagEnum[T]) Description() string {
+	return f.getConfig().enumValueToEnumDescription[int(f)]
+}
+
+// Descriptions returns a copy of all the FlagEnum[T] descriptions sorted by the flag values.
+func (f FlagEnum[T]) Descriptions() []string {
+	return slices.Clone(f.getConfig().sortedEnumDescriptions)
+}
+
+// Enums returns a list of all FlagEnum[T] declarations mapped to T
+func (f FlagEnum[T]) Enums() []FlagEnum[T] {
+	sortedEnumValues := f.getConfig().sortedEnumValues
+	values := make([]FlagEnum[T], 0, len(sortedEnumValues))
+	for _, value := range sortedEnumValues {
+		values = append(values, FlagEnum[T](value))
+	}
+
+	return values
+}
+
+// Has reports whether all the bits of flag are set in f.
+func (f FlagEnum[T]) Has(flag FlagEnum[T]) bool {
+	return f&flag == flag
+}
+
+// Set returns f with all the bits of flag set.
+func (f FlagEnum[T]) Set(flag FlagEnum[T]) FlagEnum[T] {
+	return f | flag
+}
+
+// Clear returns f with all the bits of flag cleared.
+func (f FlagEnum[T]) Clear(flag FlagEnum[T]) FlagEnum[T] {
+	return f &^ flag
+}
+
+// Toggle returns f with all the bits of flag flipped.
+func (f FlagEnum[T]) Toggle(flag FlagEnum[T]) FlagEnum[T] {
+	return f ^ flag
+}
+
+// IsValid reports whether all the bits of f are part of the T mapping.
+func (f FlagEnum[T]) IsValid() bool {
+	config := f.getConfig()
+	_, ok := config.joinFlags(int(f), config.enumValueToEnumName)
+	return ok
+}
+
+// Label returns the FlagEnum[T] display label declared with the label tag, defaults to the flag name.
+// Combined flags labels are joined with "|", e.g., "Read|Write".
+func (f FlagEnum[T]) Label() string {
+	config := f.getConfig()
+	label, ok := config.joinFlags(int(f), config.enumValueToEnumLabel)
+	if !ok {
+		return f.Name()
+	}
+
+	return label
+}
+
+// Labels returns a copy of all the FlagEnum[T] display labels sorted by the flag values.
+func (f FlagEnum[T]) Labels() []string {
+	return slices.Clone(f.getConfig().sortedEnumLabels)
+}
+
+// Name returns the FlagEnum[T] programmatic string representation,
+// combined flags are joined with "|", e.g., "Read|Write".
+// A value with bits that aren't part of the T mapping is returned as "type(value)",
+// unless StrictString(true) is set, in which case Name panics.
+func (f FlagEnum[T]) Name() string {
+	name, err := f.TryName()
+	if err != nil {
+		return f.getConfig().unknownString(int(f), err)
+	}
+
+	return name
+}
+
+// TryName returns the FlagEnum[T] programmatic string representation,
+// or an error if f has bits that aren't part of the T mapping.
+func (f FlagEnum[T]) TryName() (string, error) {
+	config := f.getConfig()
+	name, ok := config.joinFlags(int(f), config.enumValueToEnumName)
+	if !ok {
+		return "", fmt.Errorf(enumValueNotExistsErrorFormat, f, f)
+	}
+
+	return name, nil
+}
+
+// Names returns a copy of all the FlagEnum[T] programmatic string representations sorted by the flag values.
+func (f FlagEnum[T]) Names() []string {
+	return slices.Clone(f.getConfig().sortedEnumNames)
+}
+
+// MarshalText implements the TextMarshaler interface for T.
+func (f FlagEnum[T]) MarshalText() ([]byte, error) {
+	name, err := f.TryName()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(name), nil
+}
+
+// UnmarshalText implements the TextUnmarshaler interface for T.
+func (f *FlagEnum[T]) UnmarshalText(text []byte) error {
+	flag, err := f.Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*f = flag
+	return nil
+}
+
+// Pairs returns an iterator over all FlagEnum[T] names and declarations sorted by the enum values.
+func (f FlagEnum[T]) Pairs() iter.Seq2[string, FlagEnum[T]] {
+	return func(yield func(string, FlagEnum[T]) bool) {
+		config := FlagEnum[T](0).getConfig()
+		for i, value := range config.sortedEnumValues {
+			if !yield(config.sortedEnumNames[i], FlagEnum[T](value)) {
+				return
+			}
+		}
+	}
+}
+
+// Parse tries to parse "|" separated flag names based on the underline flag name to flag value mapping,
+// an empty string is parsed as the zero value.
+// If CaseInsensitive(true) is set, Parse will use the lowered case name to value mapping instead.
+func (f FlagEnum[T]) Parse(name string) (FlagEnum[T], error) {
+	config := f.getConfig()
+
+	var flag FlagEnum[T]
+	if strings.TrimSpace(name) == "" {
+		return flag, nil
+	}
+
+	for _, flagName := range strings.Split(name, flagSeparator) {
+		value, err := config.parse(strings.TrimSpace(flagName))
+		if err != nil {
+			return -1, err
+		}
+
+		flag |= FlagEnum[T](value)
+	}
+
+	return flag, nil
+}
+
+// String returns the string representation of a FlagEnum[T] value,
+// combined flags are joined with "|", e.g., "Read|Write".
+// A value with bits that aren't part of the T mapping is returned as "type(value)",
+// unless StrictString(true) is set, in which case String panics.
+func (f FlagEnum[T]) String() string {
+	flagString, err := f.TryString()
+	if err != nil {
+		return f.getConfig().unknownString(int(f), err)
+	}
+
+	return flagString
+}
+
+// TryString returns the string representation of a FlagEnum[T] value,
+// or an error if f has bits that aren't part of the T mapping.
+func (f FlagEnum[T]) TryString() (string, error) {
+	config := f.getConfig()
+	flagString, ok := config.joinFlags(int(f), config.enumValueToEnumString)
+	if !ok {
+		return "", fmt.Errorf(enumValueNotExistsErrorFormat, f, f)
 	}
 
-	newConfig := newEnumMetadata[T]()
-	cache.Set(enumType, newConfig)
+	return flagString, nil
+}
+
+// Strings returns a copy of all the FlagEnum[T] string representations sorted by the flag values.
+func (f FlagEnum[T]) Strings() []string {
+	return slices.Clone(f.getConfig().sortedEnumStrings)
+}
+
+// Type returns the underline T type.
+func (f FlagEnum[T]) Type() string {
+	return f.getConfig().typeName
+}
+
+// Values returns a copy of all the FlagEnum[T] int representations sorted by the flag values.
+func (f FlagEnum[T]) Values() []int {
+	return slices.Clone(f.getConfig().sortedEnumValues)
+}
+
+// NameList returns a read-only view of all the FlagEnum[T] names sorted by the flag values, without copying them.
+func (f FlagEnum[T]) NameList() List[string] {
+	return List[string]{items: f.getConfig().sortedEnumNames}
+}
+
+// StringList returns a read-only view of all the FlagEnum[T] strings sorted by the flag values, without copying them.
+func (f FlagEnum[T]) StringList() List[string] {
+	return List[string]{items: f.getConfig().sortedEnumStrings}
+}
+
+// ValueList returns a read-only view of all the FlagEnum[T] ints sorted by the flag values, without copying them.
+func (f FlagEnum[T]) ValueList() List[int] {
+	return List[int]{items: f.getConfig().sortedEnumValues}
+}
+
+func (f FlagEnum[T]) getConfig() *enumMetadata {
+	return mustEnumMetadata(cache.loadOrBuild(FlagEnum[T](0), tryNewFlagEnumMetadata[T]))
+}
+
+// indexOf returns the index of value in the FlagEnum[T] values sorted by value, or false if it isn't one of them.
+func (f FlagEnum[T]) indexOf(value int) (int, bool) {
+	return f.getConfig().index(value)
+}
+
+// formatUnknown returns the "Type(value)" representation String uses for a value that isn't part of FlagEnum[T].
+func (f FlagEnum[T]) formatUnknown(value int) string {
+	return f.getConfig().formatUnknown(value)
+}
 
-	return newConfig
+// register builds and caches the FlagEnum[T] metadata, returning the definition problems instead of panicking.
+func (f FlagEnum[T]) register() error {
+	_, err := cache.loadOrBuild(FlagEnum[T](0), tryNewFlagEnumMetadata[T])
+	return err
 }
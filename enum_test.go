@@ -42,10 +42,24 @@ func TestReceiverString_OnDifferentEnum_ThenReturnDifferentName(t *testing.T) {
 	assert.Equal(t, "Cow", actualString)
 }
 
-func TestReceiverString_OnEnumNotRegisteredInConfig_ThenPanic(t *testing.T) {
+func TestReceiverString_OnEnumNotRegisteredInConfig_ThenReturnTypeAndValue(t *testing.T) {
 	// Arrange
 	const notRegisteredEnum testAnimal = 10
 
+	// Act
+	actualString := notRegisteredEnum.String()
+
+	// Assert
+	assert.Equal(t, "Animal(10)", actualString)
+}
+
+func TestReceiverString_OnEnumNotRegisteredInConfigAndStrictString_ThenPanic(t *testing.T) {
+	// Arrange
+	SetOptions(StrictString(true))
+	defer SetOptions(StrictString(false))
+
+	const notRegisteredEnum testAnimal = 10
+
 	// Act
 	// Assert
 	assert.Panics(t, func() {
@@ -53,6 +67,25 @@ func TestReceiverString_OnEnumNotRegisteredInConfig_ThenPanic(t *testing.T) {
 	})
 }
 
+func TestReceiverTryString_OnEnumNotRegisteredInConfig_ThenReturnError(t *testing.T) {
+	// Arrange
+	const notRegisteredEnum testAnimal = 10
+
+	// Act
+	_, err := notRegisteredEnum.TryString()
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestReceiverIsValid_OnRegisteredAndNotRegisteredEnums_ThenReturnWhetherRegistered(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	assert.True(t, chicken.IsValid())
+	assert.False(t, testAnimal(10).IsValid())
+}
+
 func TestReceiverStrings_OnDefaultConfig_ThenReturnStrings(t *testing.T) {
 	// Arrange
 	// Act
@@ -62,6 +95,28 @@ func TestReceiverStrings_OnDefaultConfig_ThenReturnStrings(t *testing.T) {
 	assert.Equal(t, []string{"Chic\tken", "Dog", "Cat", "Cow"}, actualStrings)
 }
 
+func TestReceiverName_OnEnumNotRegisteredInConfig_ThenReturnTypeAndValue(t *testing.T) {
+	// Arrange
+	const notRegisteredEnum testAnimal = -2
+
+	// Act
+	actualName := notRegisteredEnum.Name()
+
+	// Assert
+	assert.Equal(t, "Animal(-2)", actualName)
+}
+
+func TestReceiverTryName_OnEnumNotRegisteredInConfig_ThenReturnError(t *testing.T) {
+	// Arrange
+	const notRegisteredEnum testAnimal = -2
+
+	// Act
+	_, err := notRegisteredEnum.TryName()
+
+	// Assert
+	assert.Error(t, err)
+}
+
 func TestReceiverName_OnDefaultConfig_ThenReturnName(t *testing.T) {
 	// Arrange
 	// Act
@@ -108,6 +163,52 @@ func TestReceiverEnums_OnMultipleEnums_ThenReturnAll(t *testing.T) {
 	assert.Equal(t, []testAnimal{chicken, dog, cat, cow}, actualEnums)
 }
 
+func TestReceiverAll_OnMultipleEnums_ThenIterateSortedByValue(t *testing.T) {
+	// Arrange
+	var actualEnums []testAnimal
+
+	// Act
+	for enum := range dog.All() {
+		actualEnums = append(actualEnums, enum)
+	}
+
+	// Assert
+	assert.Equal(t, []testAnimal{chicken, dog, cat, cow}, actualEnums)
+}
+
+func TestReceiverBackward_OnBreak_ThenStopIterating(t *testing.T) {
+	// Arrange
+	var actualEnums []testAnimal
+
+	// Act
+	for enum := range dog.Backward() {
+		if enum == dog {
+			break
+		}
+
+		actualEnums = append(actualEnums, enum)
+	}
+
+	// Assert
+	assert.Equal(t, []testAnimal{cow, cat}, actualEnums)
+}
+
+func TestReceiverPairs_OnMultipleEnums_ThenIterateNamesAndEnums(t *testing.T) {
+	// Arrange
+	actualNameToEnum := make(map[string]testAnimal)
+
+	// Act
+	for name, enum := range dog.Pairs() {
+		actualNameToEnum[name] = enum
+	}
+
+	// Assert
+	assert.Equal(
+		t,
+		map[string]testAnimal{"Chic\tken": chicken, "Dog": dog, "Cat": cat, "Cow": cow},
+		actualNameToEnum)
+}
+
 func TestReceiverMarshalText_OnDefaultConfig_ThenReturnName(t *testing.T) {
 	// Arrange
 	// Act
@@ -118,6 +219,17 @@ func TestReceiverMarshalText_OnDefaultConfig_ThenReturnName(t *testing.T) {
 	assert.Equal(t, []byte("Dog"), actualTextBytes)
 }
 
+func TestReceiverMarshalText_OnEnumNotRegisteredInConfig_ThenReturnError(t *testing.T) {
+	// Arrange
+	const notRegisteredEnum testAnimal = 10
+
+	// Act
+	_, err := notRegisteredEnum.MarshalText()
+
+	// Assert
+	assert.Error(t, err)
+}
+
 func TestReceiverMarshalText_OnJsonMarshal_ThenReturnJsonEncoded(t *testing.T) {
 	// Arrange
 	// Act
@@ -196,3 +308,161 @@ func TestReceiverValues_OnDefaultConfig_ThenReturnInts(t *testing.T) {
 	// Assert
 	assert.Equal(t, []int{-1, 0, 1, 2}, actualEnum.Values())
 }
+
+const (
+	read testPermission = 1 << iota
+	write
+	execute
+	none testPermission = 0
+)
+
+type (
+	testPermission = FlagEnum[struct {
+		None permission `gnum:"value=0"`
+		Read,
+		Write,
+		Execute permission
+	}]
+	permission int
+)
+
+func TestFlagReceiverValues_OnDefaultConfig_ThenReturnPowersOfTwo(t *testing.T) {
+	// Arrange
+	// Act
+	actualValues := read.Values()
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 2, 4}, actualValues)
+}
+
+func TestFlagReceiverHas_OnCombinedFlags_ThenReturnWhetherAllBitsAreSet(t *testing.T) {
+	// Arrange
+	flags := read | write
+
+	// Act
+	// Assert
+	assert.True(t, flags.Has(read))
+	assert.True(t, flags.Has(read|write))
+	assert.False(t, flags.Has(execute))
+	assert.False(t, flags.Has(read|execute))
+}
+
+func TestFlagReceiverSetClearToggle_OnCombinedFlags_ThenReturnUpdatedFlags(t *testing.T) {
+	// Arrange
+	flags := read
+
+	// Act
+	flags = flags.Set(write)
+	flags = flags.Clear(read)
+	flags = flags.Toggle(execute)
+
+	// Assert
+	assert.Equal(t, write|execute, flags)
+}
+
+func TestFlagReceiverString_OnSingleFlag_ThenReturnName(t *testing.T) {
+	// Arrange
+	// Act
+	actualString := write.String()
+
+	// Assert
+	assert.Equal(t, "Write", actualString)
+}
+
+func TestFlagReceiverString_OnCombinedFlags_ThenReturnJoinedNames(t *testing.T) {
+	// Arrange
+	// Act
+	actualString := (execute | read).String()
+
+	// Assert
+	assert.Equal(t, "Read|Execute", actualString)
+}
+
+func TestFlagReceiverString_OnZeroFlag_ThenReturnZeroName(t *testing.T) {
+	// Arrange
+	// Act
+	actualString := none.String()
+
+	// Assert
+	assert.Equal(t, "None", actualString)
+}
+
+func TestFlagReceiverString_OnUndeclaredBits_ThenReturnTypeAndValue(t *testing.T) {
+	// Arrange
+	const undeclaredFlag testPermission = 8 | 1
+
+	// Act
+	actualString := undeclaredFlag.String()
+
+	// Assert
+	assert.Equal(t, "Permission(9)", actualString)
+	assert.False(t, undeclaredFlag.IsValid())
+}
+
+func TestFlagReceiverString_OnUndeclaredBitsAndStrictString_ThenPanic(t *testing.T) {
+	// Arrange
+	SetOptions(StrictString(true))
+	defer SetOptions(StrictString(false))
+
+	const undeclaredFlag testPermission = 8 | 1
+
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		_ = undeclaredFlag.String()
+	})
+}
+
+func TestFlagReceiverParse_OnCombinedFlags_ThenReturnFlags(t *testing.T) {
+	// Arrange
+	// Act
+	actualFlags, err := none.Parse("Read | Write")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, read|write, actualFlags)
+}
+
+func TestFlagReceiverParse_OnEmptyString_ThenReturnZero(t *testing.T) {
+	// Arrange
+	// Act
+	actualFlags, err := none.Parse("")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, none, actualFlags)
+}
+
+func TestFlagReceiverParse_OnNonExistingFlagName_ThenReturnError(t *testing.T) {
+	// Arrange
+	// Act
+	_, err := none.Parse("Read|nop")
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestFlagReceiverMarshalText_OnJsonMarshalAndUnmarshal_ThenReturnSameFlags(t *testing.T) {
+	// Arrange
+	expected := &struct{ Permission testPermission }{read | execute}
+	actual := &struct{ Permission testPermission }{}
+
+	// Act
+	actualJsonBytes, err := json.Marshal(expected)
+	require.NoError(t, err)
+	err = json.Unmarshal(actualJsonBytes, actual)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "{\"Permission\":\"Read|Execute\"}", string(actualJsonBytes))
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlagEnums_OnEnumerHelpers_ThenReturnAll(t *testing.T) {
+	// Arrange
+	// Act
+	actualFlags := Enums[testPermission]()
+
+	// Assert
+	assert.Equal(t, []testPermission{none, read, write, execute}, actualFlags)
+}
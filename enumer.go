@@ -1,26 +1,96 @@
 package gnum
 
+import (
+	"fmt"
+	"iter"
+)
+
 // Enumer is an interface for using Enum instances with generics,
 // e.g, `func foo[T Enumer[T]](enum T)` could do any Enum operations
 // while preserving the original Enum type (T)
 type Enumer[T ~int] interface {
 	~int
+	All() iter.Seq[T]
+	Backward() iter.Seq[T]
+	Description() string
+	Descriptions() []string
 	Enums() []T
+	IsValid() bool
+	Label() string
+	Labels() []string
 	Name() string
+	NameList() List[string]
 	Names() []string
+	Pairs() iter.Seq2[string, T]
 	Parse(name string) (T, error)
 	String() string
+	StringList() List[string]
 	Strings() []string
 	Type() string
+	ValueList() List[int]
 	Values() []int
 }
 
+// All is a static function to handel all enums that implements Enumer[T] interface.
+// It returns an iterator over all Enum[T] declarations sorted by the enum values.
+func All[T Enumer[T]]() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range T.ValueList(-1).items {
+			if !yield(T(value)) {
+				return
+			}
+		}
+	}
+}
+
+// Backward is a static function to handel all enums that implements Enumer[T] interface.
+// It returns an iterator over all Enum[T] declarations in reverse order of the enum values.
+func Backward[T Enumer[T]]() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		values := T.ValueList(-1).items
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(T(values[i])) {
+				return
+			}
+		}
+	}
+}
+
+// Descriptions is a static function to handel all enums that implements Enumer[T] interface.
+// It returns a list of all Enum[T] descriptions sorted by the enum values.
+func Descriptions[T Enumer[T]]() []string {
+	return T.Descriptions(-1)
+}
+
 // Enums is a static function to handel all enums that implements Enumer[T] interface.
 // It returns a list of all Enum[T] declarations mapped to T.
 func Enums[T Enumer[T]]() []T {
 	return T.Enums(-1)
 }
 
+// FromValue is a static function to handel all enums that implements Enumer[T] interface.
+// It converts value to T, or returns an error if value isn't part of the T mapping.
+func FromValue[T Enumer[T]](value int) (T, error) {
+	enum := T(value)
+	if !enum.IsValid() {
+		return -1, fmt.Errorf(enumValueNotExistsErrorFormat, value, enum)
+	}
+
+	return enum, nil
+}
+
+// Labels is a static function to handel all enums that implements Enumer[T] interface.
+// It returns a list of all Enum[T] display labels sorted by the enum values.
+func Labels[T Enumer[T]]() []string {
+	return T.Labels(-1)
+}
+
+// NameList is a static function to handel all enums that implements Enumer[T] interface.
+// It returns a read-only view of all Enum[T] names, without copying them.
+func NameList[T Enumer[T]]() List[string] {
+	return T.NameList(-1)
+}
+
 // Names is a static function to handel all enums that implements Enumer[T] interface.
 // It returns a list of all Enum[T] names.
 // (the programmatic string representation of the enum value).
@@ -28,6 +98,19 @@ func Names[T Enumer[T]]() []string {
 	return T.Names(-1)
 }
 
+// Pairs is a static function to handel all enums that implements Enumer[T] interface.
+// It returns an iterator over all Enum[T] names and declarations sorted by the enum values.
+func Pairs[T Enumer[T]]() iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		names, values := T.NameList(-1).items, T.ValueList(-1).items
+		for i, value := range values {
+			if !yield(names[i], T(value)) {
+				return
+			}
+		}
+	}
+}
+
 // Parse is a static function to handel all enums that implements Enumer[T] interface.
 // It will try to parse the given name with the underline Enum.Parse implementation.
 func Parse[T Enumer[T]](name string) (T, error) {
@@ -39,6 +122,12 @@ func Parse[T Enumer[T]](name string) (T, error) {
 	return enum, nil
 }
 
+// StringList is a static function to handel all enums that implements Enumer[T] interface.
+// It returns a read-only view of all Enum[T] strings, without copying them.
+func StringList[T Enumer[T]]() List[string] {
+	return T.StringList(-1)
+}
+
 // Strings is a static function to handel all enums that implements Enumer[T] interface.
 // It returns a list of all Enum[T] strings.
 func Strings[T Enumer[T]]() []string {
@@ -51,6 +140,12 @@ func Type[T Enumer[T]]() string {
 	return T.Type(-1)
 }
 
+// ValueList is a static function to handel all enums that implements Enumer[T] interface.
+// It returns a read-only view of all Enum[T] ints, without copying them.
+func ValueList[T Enumer[T]]() List[int] {
+	return T.ValueList(-1)
+}
+
 // Values is a static function to handel all enums that implements Enumer[T] interface.
 // It returns a list of all Enum[T] ints.
 func Values[T Enumer[T]]() []int {
@@ -67,3 +67,113 @@ func TestValues_OnMultipleEnums_ThenReturnInts(t *testing.T) {
 	// Assert
 	assert.Equal(t, []int{-1, 0, 1, 2}, Values[testAnimal]())
 }
+
+func TestFromValue_OnExistingEnumValue_ThenReturnEnum(t *testing.T) {
+	// Arrange
+	// Act
+	actualEnum, err := FromValue[testAnimal](-1)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, chicken, actualEnum)
+}
+
+func TestFromValue_OnNonExistingEnumValue_ThenReturnError(t *testing.T) {
+	// Arrange
+	// Act
+	_, err := FromValue[testAnimal](3)
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestAll_OnMultipleEnums_ThenIterateWithoutAllocations(t *testing.T) {
+	// Arrange
+	var actualEnums []testAnimal
+	for enum := range All[testAnimal]() {
+		actualEnums = append(actualEnums, enum)
+	}
+
+	// Act
+	allocations := testing.AllocsPerRun(100, func() {
+		for range All[testAnimal]() {
+		}
+		for range Backward[testAnimal]() {
+		}
+		for range Pairs[testAnimal]() {
+		}
+	})
+
+	// Assert
+	assert.Equal(t, []testAnimal{chicken, dog, cat, cow}, actualEnums)
+	assert.Zero(t, allocations)
+}
+
+func TestBackward_OnMultipleEnums_ThenIterateInReverse(t *testing.T) {
+	// Arrange
+	var actualEnums []testAnimal
+
+	// Act
+	for enum := range Backward[testAnimal]() {
+		actualEnums = append(actualEnums, enum)
+	}
+
+	// Assert
+	assert.Equal(t, []testAnimal{cow, cat, dog, chicken}, actualEnums)
+}
+
+func TestPairs_OnMultipleEnums_ThenIterateSortedByValue(t *testing.T) {
+	// Arrange
+	var (
+		actualNames []string
+		actualEnums []testAnimal
+	)
+
+	// Act
+	for name, enum := range Pairs[testAnimal]() {
+		actualNames = append(actualNames, name)
+		actualEnums = append(actualEnums, enum)
+	}
+
+	// Assert
+	assert.Equal(t, Names[testAnimal](), actualNames)
+	assert.Equal(t, []testAnimal{chicken, dog, cat, cow}, actualEnums)
+}
+
+func TestNames_OnModifiedResult_ThenEnumNotChanged(t *testing.T) {
+	// Arrange
+	names := Names[testAnimal]()
+	values := Values[testAnimal]()
+
+	// Act
+	names[0] = "Horse"
+	values[0] = 7
+	_ = append(Strings[testAnimal]()[:1], "Horse")
+
+	// Assert
+	assert.Equal(t, []string{"Chic\tken", "Dog", "Cat", "Cow"}, Names[testAnimal]())
+	assert.Equal(t, []string{"Chic\tken", "Dog", "Cat", "Cow"}, Strings[testAnimal]())
+	assert.Equal(t, []int{-1, 0, 1, 2}, Values[testAnimal]())
+	assert.Equal(t, "Chic\tken", chicken.Name())
+}
+
+func TestNameList_OnMultipleEnums_ThenReturnViewWithoutAllocations(t *testing.T) {
+	// Arrange
+	var actualNames []string
+
+	// Act
+	allocations := testing.AllocsPerRun(100, func() {
+		names, values := NameList[testAnimal](), ValueList[testAnimal]()
+		for i := range names.Len() {
+			_, _ = names.At(i), values.At(i)
+		}
+	})
+	for _, name := range StringList[testAnimal]().All() {
+		actualNames = append(actualNames, name)
+	}
+
+	// Assert
+	assert.Zero(t, allocations)
+	assert.Equal(t, []string{"Chic\tken", "Dog", "Cat", "Cow"}, actualNames)
+	assert.Equal(t, []int{-1, 0, 1, 2}, ValueList[testAnimal]().Slice())
+}
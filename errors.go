@@ -0,0 +1,73 @@
+package gnum
+
+import (
+	"errors"
+	"github.com/joelboim/gnum/infra"
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the maximum number of suggestions a ParseError holds.
+const maxSuggestions = 3
+
+// ErrUnknownName is the sentinel error of every ParseError, to be used with errors.Is.
+var ErrUnknownName = errors.New("unknown enum name")
+
+// ParseError is returned by Enum.Parse and Enum.UnmarshalText when a name isn't part of the enum mapping.
+type ParseError struct {
+	// Input is the name that failed to parse.
+	Input string
+	// Type is the underline enum type name.
+	Type string
+	// ValidNames are the enum names sorted by the enum values.
+	ValidNames []string
+	// Suggestions are the valid names closest to Input by edit distance, closest first.
+	Suggestions []string
+}
+
+// Error returns the invalid input with the valid names and suggestions if there are any.
+func (e *ParseError) Error() string {
+	message := "`" + e.Input + "`" + " isn't part of [" + strings.Join(e.ValidNames, ", ") + "]"
+	if len(e.Suggestions) == 0 {
+		return message
+	}
+
+	return message + ", did you mean `" + strings.Join(e.Suggestions, "` or `") + "`?"
+}
+
+// Unwrap returns ErrUnknownName.
+func (e *ParseError) Unwrap() error {
+	return ErrUnknownName
+}
+
+// newParseError returns a *ParseError of input with up to maxSuggestions of the closest names.
+// A name is suggested when its case-insensitive edit distance from input is at most two or a third of its length, the greater of the two.
+func newParseError(input string, typeName string, names []string) *ParseError {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+
+	var suggestions []suggestion
+	for _, name := range names {
+		distance := infra.LevenshteinDistance(strings.ToLower(input), strings.ToLower(name))
+		if distance <= max(2, len(name)/3) {
+			suggestions = append(suggestions, suggestion{name, distance})
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].distance < suggestions[j].distance
+	})
+
+	parseError := &ParseError{
+		Input:      input,
+		Type:       typeName,
+		ValidNames: append([]string(nil), names...),
+	}
+	for i := 0; i < len(suggestions) && i < maxSuggestions; i++ {
+		parseError.Suggestions = append(parseError.Suggestions, suggestions[i].name)
+	}
+
+	return parseError
+}
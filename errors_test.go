@@ -0,0 +1,68 @@
+package gnum
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseError_OnNonExistingEnumName_ThenReturnParseError(t *testing.T) {
+	// Arrange
+	// Act
+	_, err := Parse[testAnimal]("Cot")
+
+	// Assert
+	var parseError *ParseError
+	require.True(t, errors.As(err, &parseError))
+	assert.True(t, errors.Is(err, ErrUnknownName))
+	assert.Equal(
+		t,
+		&ParseError{
+			Input:       "Cot",
+			Type:        "animal",
+			ValidNames:  []string{"Chic\tken", "Dog", "Cat", "Cow"},
+			Suggestions: []string{"Cat", "Cow", "Dog"},
+		},
+		parseError)
+}
+
+func TestParseError_OnSuggestions_ThenReturnMessageWithSuggestions(t *testing.T) {
+	// Arrange
+	// Act
+	_, err := Parse[testAnimal]("dgo")
+
+	// Assert
+	assert.EqualError(t, err, "`dgo` isn't part of [Chic\tken, Dog, Cat, Cow], did you mean `Dog`?")
+}
+
+func TestParseError_OnNoSuggestions_ThenReturnMessageWithoutSuggestions(t *testing.T) {
+	// Arrange
+	// Act
+	_, err := Parse[testAnimal]("Elephant")
+
+	// Assert
+	assert.EqualError(t, err, "`Elephant` isn't part of [Chic\tken, Dog, Cat, Cow]")
+}
+
+func TestParseError_OnUnmarshalText_ThenReturnParseError(t *testing.T) {
+	// Arrange
+	actualEnum := new(testAnimal)
+
+	// Act
+	err := actualEnum.UnmarshalText([]byte("Chicken"))
+
+	// Assert
+	var parseError *ParseError
+	require.True(t, errors.As(err, &parseError))
+	assert.Equal(t, []string{"Chic\tken"}, parseError.Suggestions)
+}
+
+func TestParseError_OnMoreThanMaxSuggestions_ThenReturnClosestSuggestions(t *testing.T) {
+	// Arrange
+	parseError := newParseError("abc", "letters", []string{"xyz", "xbc", "abd_", "ab", "abc_"})
+
+	// Act
+	// Assert
+	assert.Equal(t, []string{"xbc", "ab", "abc_"}, parseError.Suggestions)
+}
@@ -0,0 +1,183 @@
+package gnum
+
+import (
+	"flag"
+	"strings"
+)
+
+// flagSliceSeparator separates the enums of a single FlagSliceValue argument, e.g., "-color=Red,Blue".
+const flagSliceSeparator = ","
+
+// Shell is a command line shell that Completions can emit candidates for.
+type Shell string
+
+const (
+	// ShellBash emits the names only, one candidate per word as expected by compgen and COMPREPLY.
+	ShellBash Shell = "bash"
+	// ShellZsh emits "name:description" candidates as expected by _describe.
+	ShellZsh Shell = "zsh"
+	// ShellFish emits "name\tdescription" candidates as expected by complete -a.
+	ShellFish Shell = "fish"
+)
+
+// FlagValue is a flag.Value of T, it also implements flag.Getter and the pflag.Value interface.
+type FlagValue[T Enumer[T]] struct {
+	enum *T
+}
+
+// Flag returns a flag.Value of T set to def, e.g., `flag.Var(gnum.Flag(Red), "color", gnum.FlagUsage[Color]("car color"))`.
+func Flag[T Enumer[T]](def T) *FlagValue[T] {
+	return &FlagValue[T]{enum: &def}
+}
+
+// FlagVar defines a T flag on flagSet set to def, whose value is stored in p.
+// The usage is followed by the T names and their descriptions.
+func FlagVar[T Enumer[T]](flagSet *flag.FlagSet, p *T, name string, def T, usage string) {
+	*p = def
+	flagSet.Var(&FlagValue[T]{enum: p}, name, FlagUsage[T](usage))
+}
+
+// Enum returns the flag T.
+func (f *FlagValue[T]) Enum() T {
+	return *f.enum
+}
+
+// Get implements the flag.Getter interface.
+func (f *FlagValue[T]) Get() any {
+	return *f.enum
+}
+
+// Set implements the flag.Value interface, parsing value as a T name.
+func (f *FlagValue[T]) Set(value string) error {
+	enum, err := Parse[T](value)
+	if err != nil {
+		return err
+	}
+
+	*f.enum = enum
+	return nil
+}
+
+// String implements the flag.Value interface.
+func (f *FlagValue[T]) String() string {
+	// flag.PrintDefaults calls String on a zero value to detect the default value.
+	if f == nil || f.enum == nil {
+		return ""
+	}
+
+	return (*f.enum).Name()
+}
+
+// Type implements the pflag.Value interface, it returns the T type name.
+func (f *FlagValue[T]) Type() string {
+	return Type[T]()
+}
+
+// FlagSliceValue is a flag.Value of []T, set by repeated flags or comma separated names, e.g., "-color=Red,Blue -color=Green".
+// The first Set replaces the default enums.
+type FlagSliceValue[T Enumer[T]] struct {
+	enums *[]T
+	isSet bool
+}
+
+// FlagSlice returns a flag.Value of []T set to def.
+func FlagSlice[T Enumer[T]](def ...T) *FlagSliceValue[T] {
+	return &FlagSliceValue[T]{enums: &def}
+}
+
+// FlagSliceVar defines a []T flag on flagSet set to def, whose value is stored in p.
+// The usage is followed by the T names and their descriptions.
+func FlagSliceVar[T Enumer[T]](flagSet *flag.FlagSet, p *[]T, name string, def []T, usage string) {
+	*p = def
+	flagSet.Var(&FlagSliceValue[T]{enums: p}, name, FlagUsage[T](usage))
+}
+
+// Enums returns the flag enums.
+func (f *FlagSliceValue[T]) Enums() []T {
+	return *f.enums
+}
+
+// Get implements the flag.Getter interface.
+func (f *FlagSliceValue[T]) Get() any {
+	return *f.enums
+}
+
+// Set implements the flag.Value interface, parsing value as comma separated T names.
+func (f *FlagSliceValue[T]) Set(value string) error {
+	var enums []T
+	for _, name := range strings.Split(value, flagSliceSeparator) {
+		enum, err := Parse[T](strings.TrimSpace(name))
+		if err != nil {
+			return err
+		}
+
+		enums = append(enums, enum)
+	}
+
+	if !f.isSet {
+		*f.enums, f.isSet = nil, true
+	}
+
+	*f.enums = append(*f.enums, enums...)
+	return nil
+}
+
+// String implements the flag.Value interface.
+func (f *FlagSliceValue[T]) String() string {
+	// flag.PrintDefaults calls String on a zero value to detect the default value.
+	if f == nil || f.enums == nil {
+		return ""
+	}
+
+	names := make([]string, 0, len(*f.enums))
+	for _, enum := range *f.enums {
+		names = append(names, enum.Name())
+	}
+
+	return strings.Join(names, flagSliceSeparator)
+}
+
+// Type implements the pflag.Value interface, it returns the T type name as a slice, e.g., "[]color".
+func (f *FlagSliceValue[T]) Type() string {
+	return "[]" + Type[T]()
+}
+
+// FlagUsage returns usage followed by the T names and their descriptions,
+// e.g., "car color (Red: warm color, Blue, Green)". It can be used with pflag as well.
+func FlagUsage[T Enumer[T]](usage string) string {
+	descriptions := T.Descriptions(-1)
+	options := make([]string, 0, len(descriptions))
+	for i, name := range T.NameList(-1).items {
+		if descriptions[i] != "" {
+			name += ": " + descriptions[i]
+		}
+
+		options = append(options, name)
+	}
+
+	return usage + " (" + strings.Join(options, ", ") + ")"
+}
+
+// Completions returns the T names starting with prefix as completion candidates of shell,
+// along with their descriptions when shell supports them. An unknown shell gets the ShellBash candidates.
+func Completions[T Enumer[T]](shell Shell, prefix string) []string {
+	descriptions := T.Descriptions(-1)
+	var candidates []string
+	for i, name := range T.NameList(-1).items {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		switch {
+		case descriptions[i] == "":
+		case shell == ShellZsh:
+			name = strings.ReplaceAll(name, ":", `\:`) + ":" + descriptions[i]
+		case shell == ShellFish:
+			name += "\t" + descriptions[i]
+		}
+
+		candidates = append(candidates, name)
+	}
+
+	return candidates
+}
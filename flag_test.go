@@ -0,0 +1,138 @@
+package gnum
+
+import (
+	"bytes"
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type (
+	testShape = Enum[struct {
+		Circle   flagShape `gnum:"desc=Round"`
+		Square   flagShape `gnum:"desc=Four sides"`
+		Triangle flagShape
+	}]
+	flagShape int
+)
+
+const (
+	circle testShape = iota
+	square
+	triangle
+)
+
+var (
+	_ flag.Getter = new(FlagValue[testShape])
+	_ flag.Getter = new(FlagSliceValue[testShape])
+)
+
+func TestFlag_OnSet_ThenReturnEnum(t *testing.T) {
+	// Arrange
+	value := Flag(circle)
+
+	// Act
+	err := value.Set("Square")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, square, value.Enum())
+	assert.Equal(t, square, value.Get())
+	assert.Equal(t, "Square", value.String())
+	assert.Equal(t, "flagShape", value.Type())
+}
+
+func TestFlag_OnUnknownName_ThenReturnErrorAndKeepEnum(t *testing.T) {
+	// Arrange
+	value := Flag(circle)
+
+	// Act
+	err := value.Set("Squar")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownName)
+	assert.Equal(t, circle, value.Enum())
+}
+
+func TestFlagVar_OnParse_ThenStoreEnum(t *testing.T) {
+	// Arrange
+	var shape testShape
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	FlagVar(flagSet, &shape, "shape", triangle, "the shape")
+
+	// Act
+	err := flagSet.Parse([]string{"-shape", "Circle"})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, circle, shape)
+}
+
+func TestFlagVar_OnPrintDefaults_ThenListNamesAndDescriptions(t *testing.T) {
+	// Arrange
+	var (
+		shape  testShape
+		output bytes.Buffer
+	)
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagSet.SetOutput(&output)
+	FlagVar(flagSet, &shape, "shape", triangle, "the shape")
+
+	// Act
+	flagSet.PrintDefaults()
+
+	// Assert
+	assert.Equal(t,
+		"  -shape value\n    \tthe shape (Circle: Round, Square: Four sides, Triangle) (default Triangle)\n",
+		output.String())
+}
+
+func TestFlagSlice_OnRepeatedAndCommaSeparated_ThenReplaceDefaultAndAppend(t *testing.T) {
+	// Arrange
+	value := FlagSlice(triangle)
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagSet.Var(value, "shape", "the shapes")
+
+	// Act
+	err := flagSet.Parse([]string{"-shape", "Circle, Square", "-shape=Circle"})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []testShape{circle, square, circle}, value.Enums())
+	assert.Equal(t, "Circle,Square,Circle", value.String())
+	assert.Equal(t, "[]flagShape", value.Type())
+}
+
+func TestFlagSliceVar_OnUnknownName_ThenReturnErrorAndKeepDefault(t *testing.T) {
+	// Arrange
+	var shapes []testShape
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagSet.SetOutput(new(bytes.Buffer))
+	FlagSliceVar(flagSet, &shapes, "shape", []testShape{triangle}, "the shapes")
+
+	// Act
+	err := flagSet.Parse([]string{"-shape", "Circle,Oval"})
+
+	// Assert
+	assert.ErrorContains(t, err, "`Oval` isn't part of [Circle, Square, Triangle]")
+	assert.Equal(t, []testShape{triangle}, shapes)
+}
+
+func TestCompletions_OnShells_ThenReturnCandidatesByShellFormat(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	assert.Equal(t, []string{"Circle", "Square", "Triangle"}, Completions[testShape](ShellBash, ""))
+	assert.Equal(t, []string{"Circle:Round", "Square:Four sides", "Triangle"}, Completions[testShape](ShellZsh, ""))
+	assert.Equal(t, []string{"Circle\tRound", "Square\tFour sides", "Triangle"}, Completions[testShape](ShellFish, ""))
+}
+
+func TestCompletions_OnPrefix_ThenReturnMatchingCandidates(t *testing.T) {
+	// Arrange
+	// Act
+	candidates := Completions[testShape](ShellBash, "Sq")
+
+	// Assert
+	assert.Equal(t, []string{"Square"}, candidates)
+}
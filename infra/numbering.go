@@ -0,0 +1,102 @@
+package infra
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+)
+
+// Numbering decides the values of the enum declarations without an explicit value,
+// mirroring the const block the enum is declared with.
+// A linear numbering mirrors `Start + iota*Step`, a shift numbering mirrors `1 << (Start + iota*Step)`.
+// An explicit value restarts the numbering right after it, e.g., value=10 is followed by 11.
+// A zero Step is treated as 1, so the zero value is the `iota` numbering.
+type Numbering struct {
+	Start int
+	Step  int
+	Shift bool
+}
+
+// NumberingTagKeys maps each numbering key of the enum config tag to a function setting it,
+// the function reports whether the value is valid.
+var NumberingTagKeys = map[string]func(numbering *Numbering, value string) bool{
+	"start": func(numbering *Numbering, value string) bool {
+		start, err := strconv.Atoi(value)
+		numbering.Start = start
+		return err == nil
+	},
+	"step": func(numbering *Numbering, value string) bool {
+		step, err := strconv.Atoi(value)
+		numbering.Step = step
+		return err == nil && step != 0
+	},
+	"shift": func(numbering *Numbering, value string) bool {
+		shift, err := ParseBoolTagValue(value)
+		numbering.Shift = shift
+		return err == nil
+	},
+}
+
+// GetValues returns the value of each enum declaration, in order, given their explicit values (nil when absent).
+// On overflow it returns the values before the overflowing declaration along with an error.
+func (n Numbering) GetValues(explicitValues []*int) ([]int, error) {
+	values := make([]int, 0, len(explicitValues))
+	counter := n.Start
+	for _, explicitValue := range explicitValues {
+		if explicitValue != nil {
+			values = append(values, *explicitValue)
+			counter = n.next(n.counterOf(*explicitValue))
+			continue
+		}
+
+		value, ok := n.value(counter)
+		if !ok {
+			return values, fmt.Errorf("enum value overflows int")
+		}
+
+		values = append(values, value)
+		counter = n.next(counter)
+	}
+
+	return values, nil
+}
+
+// value returns the enum value of counter and whether it fits an int.
+func (n Numbering) value(counter int) (int, bool) {
+	if !n.Shift {
+		return counter, true
+	}
+
+	if counter < 0 || counter >= strconv.IntSize-1 {
+		return 0, false
+	}
+
+	return 1 << counter, true
+}
+
+// counterOf returns the counter of an explicit enum value,
+// for a shift numbering it is the index of the value highest bit.
+func (n Numbering) counterOf(value int) int {
+	if !n.Shift {
+		return value
+	}
+
+	if value <= 0 {
+		return n.Start - n.stepOrDefault()
+	}
+
+	return bits.Len(uint(value)) - 1
+}
+
+// next returns the counter that follows counter.
+func (n Numbering) next(counter int) int {
+	return counter + n.stepOrDefault()
+}
+
+func (n Numbering) stepOrDefault() int {
+	if n.Step == 0 {
+		return 1
+	}
+
+	return n.Step
+}
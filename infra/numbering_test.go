@@ -0,0 +1,64 @@
+package infra
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNumberingGetValues_OnZeroNumbering_ThenReturnIota(t *testing.T) {
+	// Arrange
+	ten := 10
+
+	// Act
+	actualValues, err := Numbering{}.GetValues([]*int{nil, nil, &ten, nil})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 10, 11}, actualValues)
+}
+
+func TestNumberingGetValues_OnStartAndStep_ThenReturnLinearValues(t *testing.T) {
+	// Arrange
+	// Act
+	actualValues, err := Numbering{Start: 5, Step: -2}.GetValues([]*int{nil, nil, nil})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []int{5, 3, 1}, actualValues)
+}
+
+func TestNumberingGetValues_OnShift_ThenReturnPowersOfTwo(t *testing.T) {
+	// Arrange
+	zero, twenty := 0, 20
+
+	// Act
+	actualValues, err := Numbering{Shift: true}.GetValues([]*int{&zero, nil, nil, &twenty, nil})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 2, 20, 32}, actualValues)
+}
+
+func TestNumberingGetValues_OnShiftOverflow_ThenReturnValuesBeforeOverflowAndError(t *testing.T) {
+	// Arrange
+	// Act
+	actualValues, err := Numbering{Start: 62, Shift: true}.GetValues([]*int{nil, nil})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, []int{1 << 62}, actualValues)
+}
+
+func TestNumberingTagKeys_OnInvalidValues_ThenReturnFalse(t *testing.T) {
+	// Arrange
+	numbering := &Numbering{}
+
+	// Act
+	// Assert
+	assert.False(t, NumberingTagKeys["start"](numbering, "x"))
+	assert.False(t, NumberingTagKeys["step"](numbering, "0"))
+	assert.False(t, NumberingTagKeys["shift"](numbering, "maybe"))
+	assert.True(t, NumberingTagKeys["shift"](numbering, ""))
+	assert.True(t, numbering.Shift)
+}
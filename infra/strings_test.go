@@ -0,0 +1,41 @@
+package infra
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLevenshteinDistance_OnEqualStrings_ThenReturnZero(t *testing.T) {
+	// Arrange
+	// Act
+	actualDistance := LevenshteinDistance("Blue", "Blue")
+
+	// Assert
+	assert.Equal(t, 0, actualDistance)
+}
+
+func TestLevenshteinDistance_OnEmptyString_ThenReturnOtherLength(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	assert.Equal(t, 4, LevenshteinDistance("", "Blue"))
+	assert.Equal(t, 4, LevenshteinDistance("Blue", ""))
+}
+
+func TestLevenshteinDistance_OnTransposedRunes_ThenReturnTwo(t *testing.T) {
+	// Arrange
+	// Act
+	actualDistance := LevenshteinDistance("Bleu", "Blue")
+
+	// Assert
+	assert.Equal(t, 2, actualDistance)
+}
+
+func TestLevenshteinDistance_OnKitten_ThenReturnThree(t *testing.T) {
+	// Arrange
+	// Act
+	actualDistance := LevenshteinDistance("kitten", "sitting")
+
+	// Assert
+	assert.Equal(t, 3, actualDistance)
+}
@@ -0,0 +1,343 @@
+package infra
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EnumTagAliasSeparator separates the aliases of an enum tag, e.g., `gnum:"alias=crimson|scarlet"`.
+	EnumTagAliasSeparator = "|"
+
+	tagOptionSeparator = ','
+	tagKeySeparator    = '='
+	tagQuote           = '\''
+	tagEscape          = '\\'
+)
+
+// EnumTag is the parsed `gnum` tag of an enum declaration field.
+type EnumTag struct {
+	Name        *string
+	Value       *int
+	Aliases     []string
+	Description *string
+	Label       *string
+}
+
+// EnumConfigTagOption is a key and its optional value of the enum config field tag.
+type EnumConfigTagOption struct {
+	Key   string
+	Value string
+}
+
+// tagOption is a key and its optional value of a `gnum` tag.
+type tagOption struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+// enumTagKeys maps each key of an enum declaration tag to a function setting it on the EnumTag.
+var enumTagKeys = map[string]func(enumTag *EnumTag, option tagOption) error{
+	"name": func(enumTag *EnumTag, option tagOption) error {
+		if option.value == "" {
+			return fmt.Errorf("enum name can't be empty")
+		}
+
+		enumTag.Name = &option.value
+		return nil
+	},
+	"value": func(enumTag *EnumTag, option tagOption) error {
+		value, err := strconv.Atoi(option.value)
+		if err != nil {
+			return fmt.Errorf("enum value `%s` isn't an int", option.value)
+		}
+
+		enumTag.Value = &value
+		return nil
+	},
+	"alias": func(enumTag *EnumTag, option tagOption) error {
+		for _, alias := range strings.Split(option.value, EnumTagAliasSeparator) {
+			if alias == "" {
+				return fmt.Errorf("enum alias can't be empty")
+			}
+
+			enumTag.Aliases = append(enumTag.Aliases, alias)
+		}
+
+		return nil
+	},
+	"desc": func(enumTag *EnumTag, option tagOption) error {
+		enumTag.Description = &option.value
+		return nil
+	},
+	"label": func(enumTag *EnumTag, option tagOption) error {
+		enumTag.Label = &option.value
+		return nil
+	},
+}
+
+// repeatableEnumTagKeys are the enum declaration tag keys that can appear more than once.
+var repeatableEnumTagKeys = map[string]bool{"alias": true}
+
+// ParseEnumTag parses the `gnum` tag of an enum declaration field, e.g., `value=3,name=b_l_u_e`.
+// Values can be single quoted, e.g., `desc='Red, green and blue'`, and special characters
+// can be escaped with a backslash, e.g., `name=a\,b`.
+func ParseEnumTag(rawFieldTag string) (*EnumTag, error) {
+	options, err := parseTag(rawFieldTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(options) == 0 {
+		return nil, fmt.Errorf("enum definition not found - `%s`", rawFieldTag)
+	}
+
+	enumTag := &EnumTag{}
+	seenKeys := make(map[string]bool, len(options))
+	for _, option := range options {
+		setEnumTag, ok := enumTagKeys[option.key]
+		if !ok {
+			return nil, fmt.Errorf("unknown key `%s` - `%s`", option.key, rawFieldTag)
+		}
+
+		if !option.hasValue {
+			return nil, fmt.Errorf("missing value of `%s` - `%s`", option.key, rawFieldTag)
+		}
+
+		if seenKeys[option.key] && !repeatableEnumTagKeys[option.key] {
+			return nil, fmt.Errorf("duplicate key `%s` - `%s`", option.key, rawFieldTag)
+		}
+
+		seenKeys[option.key] = true
+		if err := setEnumTag(enumTag, option); err != nil {
+			return nil, fmt.Errorf("%s - `%s`", err, rawFieldTag)
+		}
+	}
+
+	return enumTag, nil
+}
+
+// EnumConfigTagKeys maps each key of the enum config tag to a function reporting whether its value is valid,
+// so the gnum package and its analyzer reject the same tags.
+var EnumConfigTagKeys = map[string]func(value string) bool{
+	"case_insensitive":   isBoolTagValue,
+	"strict_string":      isBoolTagValue,
+	"naming_insensitive": isBoolTagValue,
+	"json":               isOneOfTagValues("name", "string", "number", "object"),
+	"string":             isOneOfTagValues("snake", "kebab", "screaming_snake", "lower_camel", "title"),
+	"type":               func(value string) bool { return value != "" },
+	"sql":                isOneOfTagValues("value", "name"),
+}
+
+func init() {
+	for key, setNumbering := range NumberingTagKeys {
+		EnumConfigTagKeys[key] = func(value string) bool {
+			return setNumbering(new(Numbering), value)
+		}
+	}
+}
+
+// ParseBoolTagValue returns the value of a boolean key of the enum config tag, a key without a value is true.
+func ParseBoolTagValue(value string) (bool, error) {
+	if value == "" {
+		return true, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+func isBoolTagValue(value string) bool {
+	_, err := ParseBoolTagValue(value)
+	return err == nil
+}
+
+func isOneOfTagValues(values ...string) func(value string) bool {
+	return func(value string) bool {
+		return slices.Contains(values, value)
+	}
+}
+
+// ParseEnumConfigTag parses the `gnum` tag of the enum config field, e.g., `case_insensitive,sql=name`.
+// It follows the same grammar as ParseEnumTag, the keys are validated by the caller with EnumConfigTagKeys.
+func ParseEnumConfigTag(rawFieldTag string) ([]EnumConfigTagOption, error) {
+	options, err := parseTag(rawFieldTag)
+	if err != nil {
+		return nil, err
+	}
+
+	configOptions := make([]EnumConfigTagOption, 0, len(options))
+	for _, option := range options {
+		configOptions = append(configOptions, EnumConfigTagOption{Key: option.key, Value: option.value})
+	}
+
+	return configOptions, nil
+}
+
+// parseTag splits a `gnum` tag to its options, e.g., `case_insensitive,sql=name`.
+// Keys are trimmed and must be made of letters, digits and underscores.
+// Values are trimmed unless single quoted, inside a quoted value a quote is escaped with a backslash.
+// Outside quotes, a backslash escapes the next character, so `,`, `=` and `'` can be part of a value.
+func parseTag(rawTag string) ([]tagOption, error) {
+	if strings.TrimSpace(rawTag) == "" {
+		return nil, nil
+	}
+
+	var options []tagOption
+	tagScanner := &tagScanner{raw: rawTag}
+	for {
+		option, err := tagScanner.scanOption()
+		if err != nil {
+			return nil, fmt.Errorf("%s - `%s`", err, rawTag)
+		}
+
+		options = append(options, option)
+		if tagScanner.done() {
+			return options, nil
+		}
+
+		tagScanner.position++
+	}
+}
+
+// tagScanner scans the options of a raw tag, position is the byte offset of the next character.
+type tagScanner struct {
+	raw      string
+	position int
+}
+
+func (s *tagScanner) done() bool {
+	return s.position >= len(s.raw)
+}
+
+func (s *tagScanner) peek() byte {
+	return s.raw[s.position]
+}
+
+// scanOption scans a single option, stopping at the option separator or the end of the tag.
+func (s *tagScanner) scanOption() (tagOption, error) {
+	keyStart := s.position
+	for !s.done() && s.peek() != tagKeySeparator && s.peek() != tagOptionSeparator {
+		s.position++
+	}
+
+	option := tagOption{key: strings.TrimSpace(s.raw[keyStart:s.position])}
+	if err := validateTagKey(option.key); err != nil {
+		return tagOption{}, err
+	}
+
+	if s.done() || s.peek() == tagOptionSeparator {
+		return option, nil
+	}
+
+	s.position++
+	value, err := s.scanValue(option.key)
+	if err != nil {
+		return tagOption{}, err
+	}
+
+	option.value = value
+	option.hasValue = true
+	return option, nil
+}
+
+// scanValue scans the value of key, either single quoted or bare.
+func (s *tagScanner) scanValue(key string) (string, error) {
+	s.skipSpaces()
+	if !s.done() && s.peek() == tagQuote {
+		return s.scanQuotedValue(key)
+	}
+
+	var (
+		value      strings.Builder
+		escapedEnd int
+	)
+	for !s.done() && s.peek() != tagOptionSeparator {
+		switch character := s.peek(); character {
+		case tagEscape:
+			if s.position+1 >= len(s.raw) {
+				return "", fmt.Errorf("dangling `\\` in value of `%s`", key)
+			}
+
+			s.position++
+			value.WriteByte(s.peek())
+			escapedEnd = value.Len()
+		case tagKeySeparator, tagQuote:
+			return "", fmt.Errorf(
+				"unexpected `%c` in value of `%s` at offset %d, quote or escape it",
+				character,
+				key,
+				s.position)
+		default:
+			value.WriteByte(character)
+		}
+
+		s.position++
+	}
+
+	trimmed := strings.TrimRight(value.String(), " \t")
+	if len(trimmed) < escapedEnd {
+		trimmed = value.String()[:escapedEnd]
+	}
+
+	return trimmed, nil
+}
+
+// scanQuotedValue scans a single quoted value of key, the scanner is at the opening quote.
+func (s *tagScanner) scanQuotedValue(key string) (string, error) {
+	quoteStart := s.position
+	s.position++
+
+	var value strings.Builder
+	for {
+		if s.done() {
+			return "", fmt.Errorf("unterminated quoted value of `%s` at offset %d", key, quoteStart)
+		}
+
+		character := s.peek()
+		if character == tagQuote {
+			s.position++
+			break
+		}
+
+		if character == tagEscape && s.position+1 < len(s.raw) {
+			s.position++
+			character = s.peek()
+		}
+
+		value.WriteByte(character)
+		s.position++
+	}
+
+	s.skipSpaces()
+	if !s.done() && s.peek() != tagOptionSeparator {
+		return "", fmt.Errorf("unexpected `%c` after quoted value of `%s` at offset %d", s.peek(), key, s.position)
+	}
+
+	return value.String(), nil
+}
+
+func (s *tagScanner) skipSpaces() {
+	for !s.done() && (s.peek() == ' ' || s.peek() == '\t') {
+		s.position++
+	}
+}
+
+func validateTagKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("missing key")
+	}
+
+	for _, character := range key {
+		if character != '_' &&
+			(character < 'a' || character > 'z') &&
+			(character < 'A' || character > 'Z') &&
+			(character < '0' || character > '9') {
+			return fmt.Errorf("invalid key `%s`", key)
+		}
+	}
+
+	return nil
+}
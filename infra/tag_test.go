@@ -0,0 +1,126 @@
+package infra
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseEnumTag_OnAllKeys_ThenReturnEnumTag(t *testing.T) {
+	// Arrange
+	// Act
+	actualTag, err := ParseEnumTag("value=-3,name=Red,alias=crimson|scarlet,desc=Warm color,label=Warm red")
+	require.NoError(t, err)
+
+	// Assert
+	name, value, desc, label := "Red", -3, "Warm color", "Warm red"
+	assert.Equal(
+		t,
+		&EnumTag{
+			Name:        &name,
+			Value:       &value,
+			Aliases:     []string{"crimson", "scarlet"},
+			Description: &desc,
+			Label:       &label,
+		},
+		actualTag)
+}
+
+func TestParseEnumTag_OnNameFollowedByValue_ThenReturnBoth(t *testing.T) {
+	// Arrange
+	// Act
+	actualTag, err := ParseEnumTag("name=x,value=3")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "x", *actualTag.Name)
+	assert.Equal(t, 3, *actualTag.Value)
+}
+
+func TestParseEnumTag_OnQuotedAndEscapedValues_ThenReturnUnquotedValues(t *testing.T) {
+	// Arrange
+	// Act
+	actualTag, err := ParseEnumTag(`name = a\,b\=c , desc='Red, green = \'blue\'' ,label=' padded '`)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "a,b=c", *actualTag.Name)
+	assert.Equal(t, "Red, green = 'blue'", *actualTag.Description)
+	assert.Equal(t, " padded ", *actualTag.Label)
+}
+
+func TestParseEnumTag_OnRepeatedAliases_ThenReturnAllAliases(t *testing.T) {
+	// Arrange
+	// Act
+	actualTag, err := ParseEnumTag("alias=crimson,alias=scarlet|ruby")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []string{"crimson", "scarlet", "ruby"}, actualTag.Aliases)
+}
+
+func TestParseEnumTag_OnInvalidTags_ThenReturnErrorWithOffendingToken(t *testing.T) {
+	tests := map[string]string{
+		"":                "enum definition not found - ``",
+		"0":               "unknown key `0` - `0`",
+		"nme=Red":         "unknown key `nme` - `nme=Red`",
+		"name":            "missing value of `name` - `name`",
+		"name=":           "enum name can't be empty - `name=`",
+		"name=a,name=b":   "duplicate key `name` - `name=a,name=b`",
+		"alias=a|":        "enum alias can't be empty - `alias=a|`",
+		"value=1,value=2": "duplicate key `value` - `value=1,value=2`",
+		"value=x":         "enum value `x` isn't an int - `value=x`",
+		"name=a=b":        "unexpected `=` in value of `name` at offset 6, quote or escape it - `name=a=b`",
+		"name=a value=1":  "unexpected `=` in value of `name` at offset 12, quote or escape it - `name=a value=1`",
+		"desc='a":         "unterminated quoted value of `desc` at offset 5 - `desc='a`",
+		"desc='a'b":       "unexpected `b` after quoted value of `desc` at offset 8 - `desc='a'b`",
+		`name=a\`:         "dangling `\\` in value of `name` - `name=a\\`",
+		"name=a,,value=1": "missing key - `name=a,,value=1`",
+		"na me=a":         "invalid key `na me` - `na me=a`",
+	}
+	for rawTag, expectedError := range tests {
+		// Arrange
+		// Act
+		_, err := ParseEnumTag(rawTag)
+
+		// Assert
+		assert.EqualError(t, err, expectedError, rawTag)
+	}
+}
+
+func TestParseEnumConfigTag_OnKeysWithAndWithoutValues_ThenReturnOptions(t *testing.T) {
+	// Arrange
+	// Act
+	actualOptions, err := ParseEnumConfigTag("case_insensitive, sql=name, desc='a,b'")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(
+		t,
+		[]EnumConfigTagOption{{Key: "case_insensitive"}, {Key: "sql", Value: "name"}, {Key: "desc", Value: "a,b"}},
+		actualOptions)
+}
+
+func TestParseEnumConfigTag_OnMalformedTag_ThenReturnError(t *testing.T) {
+	// Arrange
+	// Act
+	_, err := ParseEnumConfigTag("sql=name=value")
+
+	// Assert
+	assert.EqualError(t, err, "unexpected `=` in value of `sql` at offset 8, quote or escape it - `sql=name=value`")
+}
+
+func TestEnumConfigTagKeys_OnValues_ThenReportValidity(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	assert.True(t, EnumConfigTagKeys["case_insensitive"](""))
+	assert.True(t, EnumConfigTagKeys["strict_string"]("false"))
+	assert.False(t, EnumConfigTagKeys["naming_insensitive"]("maybe"))
+	assert.True(t, EnumConfigTagKeys["json"]("number"))
+	assert.False(t, EnumConfigTagKeys["string"]("camel"))
+	assert.False(t, EnumConfigTagKeys["type"](""))
+	assert.True(t, EnumConfigTagKeys["sql"]("name"))
+	assert.False(t, EnumConfigTagKeys["step"]("0"))
+	assert.NotContains(t, EnumConfigTagKeys, "bogus")
+}
@@ -0,0 +1,152 @@
+package gnum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// JSONFormatMode decides how an Enum is marshaled to JSON.
+type JSONFormatMode int
+
+const (
+	// JSONFormatName marshals the enum name, e.g., "Red".
+	JSONFormatName JSONFormatMode = iota
+	// JSONFormatString marshals the enum string, the StringCallback output, e.g., "red".
+	JSONFormatString
+	// JSONFormatNumber marshals the enum value, e.g., 0.
+	JSONFormatNumber
+	// JSONFormatObject marshals both the enum name and value, e.g., {"name":"Red","value":0}.
+	JSONFormatObject
+)
+
+// jsonFormatModes maps the `json` enum config tag values to their JSONFormatMode, e.g., `_ struct{} gnum:"json=number"`.
+var jsonFormatModes = map[string]JSONFormatMode{
+	"name":   JSONFormatName,
+	"string": JSONFormatString,
+	"number": JSONFormatNumber,
+	"object": JSONFormatObject,
+}
+
+// JSONFormat sets how Enum.MarshalJSON represents the enum,
+// Enum.UnmarshalJSON accepts all the representations regardless of the mode.
+func JSONFormat(mode JSONFormatMode) Option {
+	return func(c *config) {
+		c.jsonFormat = mode
+	}
+}
+
+// enumJSONObject is the JSONFormatObject representation of an enum.
+type enumJSONObject struct {
+	Name  *string `json:"name"`
+	Value *int    `json:"value"`
+}
+
+// MarshalJSON implements the json.Marshaler interface for T, depending on the JSONFormat option.
+// A value that isn't part of the T mapping returns an error.
+func (e Enum[T]) MarshalJSON() ([]byte, error) {
+	name, err := e.TryName()
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.getConfig().config.jsonFormat {
+	case JSONFormatString:
+		return json.Marshal(e.String())
+	case JSONFormatNumber:
+		return json.Marshal(int(e))
+	case JSONFormatObject:
+		value := int(e)
+		return json.Marshal(enumJSONObject{Name: &name, Value: &value})
+	default:
+		return json.Marshal(name)
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for T.
+// Regardless of the JSONFormat option, it accepts a number, an enum name, alias or string,
+// a number as string and an object with the enum name, value or both, so the format can be changed
+// without breaking the existing producers. A JSON null leaves e unchanged.
+func (e *Enum[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) == 0 {
+		return fmt.Errorf("can't unmarshal empty input into `%s`", e.Type())
+	}
+
+	var (
+		enum Enum[T]
+		err  error
+	)
+	switch data[0] {
+	case '"':
+		var text string
+		if err = json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+
+		enum, err = e.unmarshalJSONText(text)
+	case '{':
+		enum, err = e.unmarshalJSONObject(data)
+	default:
+		var value int
+		if err = json.Unmarshal(data, &value); err != nil {
+			return fmt.Errorf("can't unmarshal `%s` into `%s`", data, e.Type())
+		}
+
+		enum, err = FromValue[Enum[T]](value)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*e = enum
+	return nil
+}
+
+// unmarshalJSONText parses text as an enum name or alias, then as an enum string and then as an enum value.
+func (e Enum[T]) unmarshalJSONText(text string) (Enum[T], error) {
+	enum, err := e.scanText(text)
+	if err == nil {
+		return enum, nil
+	}
+
+	config := e.getConfig()
+	if index := slices.Index(config.sortedEnumStrings, text); index >= 0 {
+		return Enum[T](config.sortedEnumValues[index]), nil
+	}
+
+	return -1, err
+}
+
+// unmarshalJSONObject parses a JSONFormatObject representation,
+// if both the name and the value are given they must be of the same enum.
+func (e Enum[T]) unmarshalJSONObject(data []byte) (Enum[T], error) {
+	var object enumJSONObject
+	if err := json.Unmarshal(data, &object); err != nil {
+		return -1, err
+	}
+
+	switch {
+	case object.Name != nil:
+		enum, err := e.Parse(*object.Name)
+		if err != nil {
+			return -1, err
+		}
+
+		if object.Value != nil && *object.Value != int(enum) {
+			return -1, fmt.Errorf("`%s` name and `%d` value aren't the same `%s`", *object.Name, *object.Value, e.Type())
+		}
+
+		return enum, nil
+	case object.Value != nil:
+		return FromValue[Enum[T]](*object.Value)
+	default:
+		return -1, fmt.Errorf("can't unmarshal `%s` into `%s`, expected a name or a value", data, e.Type())
+	}
+}
@@ -0,0 +1,113 @@
+package gnum
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+var (
+	_ json.Marshaler   = apple
+	_ json.Unmarshaler = new(testFruit)
+)
+
+func TestMarshalJSON_OnEachFormat_ThenReturnRepresentation(t *testing.T) {
+	tests := map[JSONFormatMode]string{
+		JSONFormatName:   `{"Fruit":"Banana"}`,
+		JSONFormatString: `{"Fruit":"banana"}`,
+		JSONFormatNumber: `{"Fruit":1}`,
+		JSONFormatObject: `{"Fruit":{"name":"Banana","value":1}}`,
+	}
+	for mode, expectedJSON := range tests {
+		// Arrange
+		SetOptions(JSONFormat(mode), StringCallback(strings.ToLower))
+
+		// Act
+		actualJSON, err := json.Marshal(struct{ Fruit testFruit }{banana})
+		require.NoError(t, err)
+
+		// Assert
+		assert.JSONEq(t, expectedJSON, string(actualJSON))
+	}
+
+	SetOptions(JSONFormat(JSONFormatName), StringCallback(nil))
+}
+
+func TestMarshalJSON_OnConfigFieldTag_ThenReturnNumber(t *testing.T) {
+	// Arrange
+	type (
+		status_ int
+		enum    = Enum[struct {
+			_ struct{} `gnum:"json=number"`
+			Active,
+			Disabled status_
+		}]
+	)
+
+	// Act
+	actualJSON, err := json.Marshal([]enum{1, 0})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, `[1,0]`, string(actualJSON))
+}
+
+func TestMarshalJSON_OnUnknownValue_ThenReturnError(t *testing.T) {
+	// Arrange
+	// Act
+	_, err := json.Marshal(testFruit(7))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestUnmarshalJSON_OnAnyRepresentation_ThenReturnEnum(t *testing.T) {
+	// Arrange
+	Configure[testFruit](StringCallback(strings.ToUpper))
+	defer Configure[testFruit](StringCallback(nil))
+
+	for _, data := range []string{`"Cherry"`, `"CHERRY"`, `2`, `"2"`, `{"name":"Cherry","value":2}`, `{"value":2}`, `{"name":"Cherry"}`} {
+		var actualFruit testFruit
+
+		// Act
+		err := json.Unmarshal([]byte(data), &actualFruit)
+		require.NoError(t, err, data)
+
+		// Assert
+		assert.Equal(t, cherry, actualFruit, data)
+	}
+}
+
+func TestUnmarshalJSON_OnNull_ThenKeepEnum(t *testing.T) {
+	// Arrange
+	actualFruit := cherry
+
+	// Act
+	err := json.Unmarshal([]byte(`null`), &actualFruit)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, cherry, actualFruit)
+}
+
+func TestUnmarshalJSON_OnInvalidRepresentation_ThenReturnError(t *testing.T) {
+	tests := map[string]string{
+		`"Cheery"`:                    "`Cheery` isn't part of [Apple, Banana, Cherry], did you mean `Cherry`?",
+		`7`:                           "`7` isn't part of",
+		`1.5`:                         "can't unmarshal `1.5` into `fruit`",
+		`true`:                        "can't unmarshal `true` into `fruit`",
+		`{"name":"Cherry","value":1}`: "`Cherry` name and `1` value aren't the same `fruit`",
+		`{}`:                          "can't unmarshal `{}` into `fruit`, expected a name or a value",
+	}
+	for data, expectedError := range tests {
+		var actualFruit testFruit
+
+		// Act
+		err := json.Unmarshal([]byte(data), &actualFruit)
+
+		// Assert
+		assert.ErrorContains(t, err, expectedError, data)
+	}
+}
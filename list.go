@@ -0,0 +1,39 @@
+package gnum
+
+import (
+	"iter"
+	"slices"
+)
+
+// List is a read-only view of an enum metadata list, e.g., the enum names sorted by the enum values.
+// Unlike the slices returned by Names, Strings and Values, it's shared without being copied,
+// and can't be used to modify the enum metadata.
+type List[E any] struct {
+	items []E
+}
+
+// Len returns the number of items in l.
+func (l List[E]) Len() int {
+	return len(l.items)
+}
+
+// At returns the item at index i, it panics if i is out of range.
+func (l List[E]) At(i int) E {
+	return l.items[i]
+}
+
+// All returns an iterator over the indexes and items of l.
+func (l List[E]) All() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for i, item := range l.items {
+			if !yield(i, item) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a copy of the items of l.
+func (l List[E]) Slice() []E {
+	return slices.Clone(l.items)
+}
@@ -0,0 +1,57 @@
+package gnum
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestList_OnItems_ThenReturnLenAndItems(t *testing.T) {
+	// Arrange
+	list := List[string]{items: []string{"Red", "Green", "Blue"}}
+
+	// Act
+	var (
+		actualIndexes []int
+		actualItems   []string
+	)
+	for i, item := range list.All() {
+		actualIndexes = append(actualIndexes, i)
+		actualItems = append(actualItems, item)
+	}
+
+	// Assert
+	assert.Equal(t, 3, list.Len())
+	assert.Equal(t, "Green", list.At(1))
+	assert.Equal(t, []int{0, 1, 2}, actualIndexes)
+	assert.Equal(t, []string{"Red", "Green", "Blue"}, actualItems)
+}
+
+func TestListSlice_OnModifiedSlice_ThenListNotChanged(t *testing.T) {
+	// Arrange
+	list := List[int]{items: []int{1, 2, 3}}
+
+	// Act
+	slice := list.Slice()
+	slice[0] = 7
+
+	// Assert
+	assert.Equal(t, 1, list.At(0))
+}
+
+func TestListAll_OnBreak_ThenStopIterating(t *testing.T) {
+	// Arrange
+	list := List[int]{items: []int{1, 2, 3}}
+
+	// Act
+	var actualItems []int
+	for _, item := range list.All() {
+		if item == 2 {
+			break
+		}
+
+		actualItems = append(actualItems, item)
+	}
+
+	// Assert
+	assert.Equal(t, []int{1}, actualItems)
+}
@@ -0,0 +1,150 @@
+package gnum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EnumMap maps T members to V values, backed by a slice indexed by the T members sorted by value.
+// The zero value is an empty map ready to use. Like a Go map, copies of a non-empty map share its values,
+// so Set and Delete on a copy change the original as well, use Clone for an independent copy.
+type EnumMap[T Enumer[T], V any] struct {
+	values  []V
+	present EnumSet[T]
+}
+
+// Get returns the value of enum and whether it's in m.
+func (m EnumMap[T, V]) Get(enum T) (V, bool) {
+	index, ok := getEnumIndex(enum)
+	if !ok || !m.present.containsIndex(index) {
+		return *new(V), false
+	}
+
+	return m.values[index], true
+}
+
+// Set sets the value of enum, it panics if enum isn't part of the T mapping.
+// The values are allocated for all the T members on the first Set, so they're never reallocated afterwards.
+func (m *EnumMap[T, V]) Set(enum T, value V) {
+	index := mustGetEnumIndex(enum)
+	if m.values == nil {
+		m.values = make([]V, T.ValueList(-1).Len())
+	}
+
+	m.values[index] = value
+	m.present.addIndex(index)
+}
+
+// Delete removes enum from m.
+func (m *EnumMap[T, V]) Delete(enum T) {
+	index, ok := getEnumIndex(enum)
+	if !ok || !m.present.containsIndex(index) {
+		return
+	}
+
+	m.values[index] = *new(V)
+	m.present.removeIndex(index)
+}
+
+// Clone returns a copy of m that doesn't share its values.
+func (m EnumMap[T, V]) Clone() EnumMap[T, V] {
+	return EnumMap[T, V]{values: slices.Clone(m.values), present: m.present.Clone()}
+}
+
+// Len returns the number of members in m.
+func (m EnumMap[T, V]) Len() int {
+	return m.present.Len()
+}
+
+// Range calls f for each member of m and its value sorted by the enum values, until f returns false.
+func (m EnumMap[T, V]) Range(f func(enum T, value V) bool) {
+	values := T.ValueList(-1).items
+	m.present.rangeIndexes(func(index int) bool {
+		return f(T(values[index]), m.values[index])
+	})
+}
+
+// CheckComplete returns an error listing the T members that aren't in m, if there are any.
+func (m EnumMap[T, V]) CheckComplete() error {
+	missing := m.present.Complement()
+	if missing.Len() == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, missing.Len())
+	missing.Range(func(enum T) bool {
+		names = append(names, enum.Name())
+		return true
+	})
+
+	return fmt.Errorf("`%s` enum map is missing [%s]", T.Type(-1), strings.Join(names, ", "))
+}
+
+// MustBeComplete panics if any of the T members isn't in m.
+func (m EnumMap[T, V]) MustBeComplete() {
+	if err := m.CheckComplete(); err != nil {
+		panic(err.Error())
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding m as an object keyed by the members names
+// sorted by the enum values.
+func (m EnumMap[T, V]) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	var err error
+	m.Range(func(enum T, value V) bool {
+		if buffer.Len() > 1 {
+			buffer.WriteByte(',')
+		}
+
+		var name, encodedValue []byte
+		if name, err = json.Marshal(enum.Name()); err != nil {
+			return false
+		}
+
+		if encodedValue, err = json.Marshal(value); err != nil {
+			return false
+		}
+
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(encodedValue)
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, decoding an object keyed by the members names.
+func (m *EnumMap[T, V]) UnmarshalJSON(data []byte) error {
+	var nameToRawValue map[string]json.RawMessage
+	if err := json.Unmarshal(data, &nameToRawValue); err != nil {
+		return err
+	}
+
+	var enumMap EnumMap[T, V]
+	for name, rawValue := range nameToRawValue {
+		enum, err := Parse[T](name)
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err = json.Unmarshal(rawValue, &value); err != nil {
+			return err
+		}
+
+		enumMap.Set(enum, value)
+	}
+
+	*m = enumMap
+	return nil
+}
@@ -0,0 +1,206 @@
+package gnum
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestEnumMapSet_OnMultipleEnums_ThenGetValues(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, string]
+
+	// Act
+	enumMap.Set(cow, "moo")
+	enumMap.Set(dog, "woof")
+	enumMap.Set(cow, "MOO")
+
+	// Assert
+	actualValue, ok := enumMap.Get(cow)
+	require.True(t, ok)
+	assert.Equal(t, "MOO", actualValue)
+
+	_, ok = enumMap.Get(cat)
+	assert.False(t, ok)
+	assert.Equal(t, 2, enumMap.Len())
+}
+
+func TestEnumMapSet_OnCopy_ThenOriginalChanged(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, string]
+	enumMap.Set(dog, "woof")
+	enumMapCopy := enumMap
+
+	// Act
+	enumMapCopy.Set(dog, "WOOF")
+	enumMapCopy.Set(cow, "moo")
+
+	// Assert
+	actualValue, ok := enumMap.Get(dog)
+	require.True(t, ok)
+	assert.Equal(t, "WOOF", actualValue)
+	assert.Equal(t, 2, enumMap.Len())
+}
+
+func TestEnumMapClone_OnSetAndDelete_ThenOriginalUnchanged(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, string]
+	enumMap.Set(dog, "woof")
+	enumMapClone := enumMap.Clone()
+
+	// Act
+	enumMapClone.Set(dog, "WOOF")
+	enumMapClone.Set(cow, "moo")
+	enumMapClone.Delete(dog)
+
+	// Assert
+	actualValue, ok := enumMap.Get(dog)
+	require.True(t, ok)
+	assert.Equal(t, "woof", actualValue)
+	assert.Equal(t, 1, enumMap.Len())
+	_, ok = enumMapClone.Get(dog)
+	assert.False(t, ok)
+	assert.Equal(t, 1, enumMapClone.Len())
+}
+
+func TestEnumMapSet_OnNonEmptyMap_ThenNotAllocate(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, int]
+	enumMap.Set(dog, 1)
+
+	// Act
+	allocs := testing.AllocsPerRun(100, func() {
+		enumMap.Set(cow, 2)
+		enumMap.Get(cow)
+		enumMap.Delete(cow)
+	})
+
+	// Assert
+	assert.Zero(t, allocs)
+}
+
+func TestEnumMapSet_OnEnumNotRegisteredInConfig_ThenPanic(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, string]
+
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		enumMap.Set(testAnimal(10), "")
+	})
+}
+
+func TestEnumMapGet_OnEnumNotRegisteredInConfig_ThenReturnFalse(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, string]
+
+	// Act
+	_, ok := enumMap.Get(testAnimal(10))
+
+	// Assert
+	assert.False(t, ok)
+}
+
+func TestEnumMapDelete_OnExistingEnum_ThenGetReturnFalse(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, int]
+	enumMap.Set(cat, 1)
+
+	// Act
+	enumMap.Delete(cat)
+	enumMap.Delete(dog)
+
+	// Assert
+	_, ok := enumMap.Get(cat)
+	assert.False(t, ok)
+	assert.Equal(t, 0, enumMap.Len())
+}
+
+func TestEnumMapRange_OnMultipleEnums_ThenIterateSortedByValue(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, int]
+	enumMap.Set(cow, 3)
+	enumMap.Set(chicken, 1)
+	enumMap.Set(dog, 2)
+
+	var (
+		actualEnums  []testAnimal
+		actualValues []int
+	)
+
+	// Act
+	enumMap.Range(func(enum testAnimal, value int) bool {
+		actualEnums = append(actualEnums, enum)
+		actualValues = append(actualValues, value)
+		return true
+	})
+
+	// Assert
+	assert.Equal(t, []testAnimal{chicken, dog, cow}, actualEnums)
+	assert.Equal(t, []int{1, 2, 3}, actualValues)
+}
+
+func TestEnumMapCheckComplete_OnMissingEnums_ThenReturnErrorWithMissingNames(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, int]
+	enumMap.Set(dog, 1)
+	enumMap.Set(cow, 1)
+
+	// Act
+	err := enumMap.CheckComplete()
+
+	// Assert
+	assert.EqualError(t, err, "`animal` enum map is missing [Chic\tken, Cat]")
+	assert.Panics(t, func() {
+		enumMap.MustBeComplete()
+	})
+}
+
+func TestEnumMapCheckComplete_OnAllEnums_ThenReturnNil(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, int]
+	for _, enum := range Enums[testAnimal]() {
+		enumMap.Set(enum, 1)
+	}
+
+	// Act
+	err := enumMap.CheckComplete()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotPanics(t, func() {
+		enumMap.MustBeComplete()
+	})
+}
+
+func TestEnumMapMarshalJSON_OnJsonMarshalAndUnmarshal_ThenReturnSameMap(t *testing.T) {
+	// Arrange
+	var expected EnumMap[testAnimal, []int]
+	expected.Set(cow, []int{1, 2})
+	expected.Set(chicken, nil)
+
+	var actual EnumMap[testAnimal, []int]
+	actual.Set(dog, []int{3})
+
+	// Act
+	actualJsonBytes, err := json.Marshal(expected)
+	require.NoError(t, err)
+	err = json.Unmarshal(actualJsonBytes, &actual)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "{\"Chic\\tken\":null,\"Cow\":[1,2]}", string(actualJsonBytes))
+	assert.Equal(t, expected, actual)
+}
+
+func TestEnumMapUnmarshalJSON_OnNonExistingEnumName_ThenReturnParseError(t *testing.T) {
+	// Arrange
+	var enumMap EnumMap[testAnimal, int]
+
+	// Act
+	err := json.Unmarshal([]byte("{\"Cot\":1}"), &enumMap)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownName)
+}
@@ -0,0 +1,182 @@
+package gnum
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Check compares consts with the T declarations sorted by the enum values, e.g.,
+// `gnum.Check[Color](Red, Blue, Green)`, and returns an error listing the missing members,
+// the extra consts (unknown or duplicated values) and the misordered consts, if there are any.
+// Consts are compared by value only, CheckNames also catches renamed consts whose values still line up.
+func Check[T Enumer[T]](consts ...T) error {
+	values := T.Values(-1)
+	valueToIndex := make(map[int]int, len(values))
+	for i, value := range values {
+		valueToIndex[value] = i
+	}
+
+	var (
+		problems  []string
+		extras    []string
+		seen      = make(map[int]bool, len(consts))
+		matched   = make([]int, 0, len(consts))
+		isPresent = make([]bool, len(values))
+	)
+	for _, enum := range consts {
+		index, ok := valueToIndex[int(enum)]
+		if !ok {
+			extras = append(extras, formatUnknownConst(enum))
+			continue
+		}
+
+		if seen[int(enum)] {
+			extras = append(extras, enum.Name())
+			continue
+		}
+
+		seen[int(enum)] = true
+		isPresent[index] = true
+		matched = append(matched, index)
+	}
+
+	var missing []string
+	for i, value := range values {
+		if !isPresent[i] {
+			missing = append(missing, T(value).Name())
+		}
+	}
+
+	if len(missing) > 0 {
+		problems = append(problems, "missing ["+strings.Join(missing, ", ")+"]")
+	}
+
+	if len(extras) > 0 {
+		problems = append(problems, "extra ["+strings.Join(extras, ", ")+"]")
+	}
+
+	if misordered := getMisordered[T](values, matched); len(misordered) > 0 {
+		problems = append(problems, "misordered ["+strings.Join(misordered, ", ")+"]")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("`%s` consts don't match the enum declarations: %s", T.Type(-1), strings.Join(problems, ", "))
+}
+
+// MustMatch panics if consts don't match the T declarations sorted by the enum values,
+// meant to be called from init(), see Check.
+func MustMatch[T Enumer[T]](consts ...T) {
+	if err := Check(consts...); err != nil {
+		panic(err.Error())
+	}
+}
+
+// CheckNames compares the consts names and values with the T declarations, e.g.,
+// `gnum.CheckNames(map[string]Color{"Red": Red, "Blue": Blue, "Green": Green})`, and returns an error listing
+// the missing members, the extra consts (undeclared names) and the mismatched consts, whose value belongs
+// to another member, e.g., after swapping two names in the const block.
+func CheckNames[T Enumer[T]](consts map[string]T) error {
+	var (
+		problems   []string
+		missing    []string
+		extras     []string
+		mismatched []string
+	)
+	for name, enum := range T.Pairs(-1) {
+		constEnum, ok := consts[name]
+		switch {
+		case !ok:
+			missing = append(missing, name)
+		case constEnum != enum:
+			mismatched = append(mismatched, fmt.Sprintf("`%s` is %s", name, describeConst(constEnum)))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(consts)) {
+		if !slices.Contains(T.NameList(-1).items, name) {
+			extras = append(extras, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		problems = append(problems, "missing ["+strings.Join(missing, ", ")+"]")
+	}
+
+	if len(extras) > 0 {
+		problems = append(problems, "extra ["+strings.Join(extras, ", ")+"]")
+	}
+
+	if len(mismatched) > 0 {
+		problems = append(problems, "mismatched ["+strings.Join(mismatched, ", ")+"]")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("`%s` consts don't match the enum declarations: %s", T.Type(-1), strings.Join(problems, ", "))
+}
+
+// MustMatchNames panics if the consts names and values don't match the T declarations,
+// meant to be called from init(), see CheckNames.
+func MustMatchNames[T Enumer[T]](consts map[string]T) {
+	if err := CheckNames(consts); err != nil {
+		panic(err.Error())
+	}
+}
+
+// describeConst returns the quoted enum name, or "Type(value)" if enum isn't part of the T mapping.
+func describeConst[T Enumer[T]](enum T) string {
+	if !enum.IsValid() {
+		return formatUnknownConst(enum)
+	}
+
+	return "`" + enum.Name() + "`"
+}
+
+// unknownFormatter is implemented by Enum and FlagEnum to render values that aren't part of their mapping
+// the same way String does, regardless of StrictString.
+type unknownFormatter interface {
+	formatUnknown(value int) string
+}
+
+// formatUnknownConst returns the "Type(value)" representation of an enum that isn't part of the T mapping.
+func formatUnknownConst[T Enumer[T]](enum T) string {
+	if formatter, ok := any(T(0)).(unknownFormatter); ok {
+		return formatter.formatUnknown(int(enum))
+	}
+
+	return fmt.Sprintf("%s(%d)", exportedName(T.Type(-1)), int(enum))
+}
+
+// getMisordered returns a description of each matched index that isn't in its sorted position,
+// among the matched indexes only.
+func getMisordered[T Enumer[T]](values []int, matched []int) []string {
+	expected := make([]int, 0, len(matched))
+	isMatched := make([]bool, len(values))
+	for _, index := range matched {
+		isMatched[index] = true
+	}
+
+	for index := range values {
+		if isMatched[index] {
+			expected = append(expected, index)
+		}
+	}
+
+	var misordered []string
+	for i, index := range matched {
+		if index != expected[i] {
+			misordered = append(
+				misordered,
+				fmt.Sprintf("`%s` instead of `%s`", T(values[index]).Name(), T(values[expected[i]]).Name()))
+		}
+	}
+
+	return misordered
+}
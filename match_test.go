@@ -0,0 +1,137 @@
+package gnum
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCheck_OnMatchingConsts_ThenReturnNil(t *testing.T) {
+	// Arrange
+	// Act
+	err := Check(chicken, dog, cat, cow)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotPanics(t, func() {
+		MustMatch(chicken, dog, cat, cow)
+	})
+}
+
+func TestCheck_OnMissingConsts_ThenReturnMissingNames(t *testing.T) {
+	// Arrange
+	// Act
+	err := Check(dog, cow)
+
+	// Assert
+	assert.EqualError(t, err, "`animal` consts don't match the enum declarations: missing [Chic\tken, Cat]")
+}
+
+func TestCheck_OnExtraConsts_ThenReturnExtraNames(t *testing.T) {
+	// Arrange
+	// Act
+	err := Check(chicken, dog, cat, cow, testAnimal(3), cat)
+
+	// Assert
+	assert.EqualError(t, err, "`animal` consts don't match the enum declarations: extra [Animal(3), Cat]")
+}
+
+func TestCheck_OnMisorderedConsts_ThenReturnMisorderedNames(t *testing.T) {
+	// Arrange
+	// Act
+	err := Check(chicken, cat, dog, cow)
+
+	// Assert
+	assert.EqualError(
+		t,
+		err,
+		"`animal` consts don't match the enum declarations: misordered [`Cat` instead of `Dog`, `Dog` instead of `Cat`]")
+}
+
+func TestCheck_OnAllProblems_ThenReturnAllProblems(t *testing.T) {
+	// Arrange
+	// Act
+	err := Check(cow, testAnimal(7), dog)
+
+	// Assert
+	assert.EqualError(
+		t,
+		err,
+		"`animal` consts don't match the enum declarations: missing [Chic\tken, Cat], extra [Animal(7)], "+
+			"misordered [`Cow` instead of `Dog`, `Dog` instead of `Cow`]")
+}
+
+func TestCheck_OnUnknownConstOfEnumWithTypeName_ThenRenderLikeString(t *testing.T) {
+	// Arrange
+	type (
+		vehicle_ int
+		enum     = Enum[struct {
+			_ struct{} `gnum:"type=Vehicle"`
+			Car,
+			Bus vehicle_
+		}]
+	)
+
+	// Act
+	err := Check(enum(0), enum(1), enum(7))
+
+	// Assert
+	assert.EqualError(t, err, "`vehicle_` consts don't match the enum declarations: extra ["+enum(7).String()+"]")
+	assert.Equal(t, "Vehicle(7)", enum(7).String())
+}
+
+func TestCheckNames_OnMatchingConsts_ThenReturnNil(t *testing.T) {
+	// Arrange
+	// Act
+	err := CheckNames(map[string]testAnimal{"Chic\tken": chicken, "Dog": dog, "Cat": cat, "Cow": cow})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotPanics(t, func() {
+		MustMatchNames(map[string]testAnimal{"Chic\tken": chicken, "Dog": dog, "Cat": cat, "Cow": cow})
+	})
+}
+
+func TestCheckNames_OnRenamedConstsWithMatchingValues_ThenReturnMismatchedNames(t *testing.T) {
+	// Arrange
+	// Act
+	// The values line up with the declarations, so only the names reveal the swap.
+	err := CheckNames(map[string]testAnimal{"Chic\tken": chicken, "Dog": cat, "Cat": dog, "Cow": cow})
+
+	// Assert
+	assert.NoError(t, Check(chicken, dog, cat, cow))
+	assert.EqualError(
+		t,
+		err,
+		"`animal` consts don't match the enum declarations: mismatched [`Dog` is `Cat`, `Cat` is `Dog`]")
+}
+
+func TestCheckNames_OnMissingExtraAndUnknownConsts_ThenReturnAllProblems(t *testing.T) {
+	// Arrange
+	// Act
+	err := CheckNames(map[string]testAnimal{"Dog": dog, "Calf": cat, "Cow": testAnimal(7)})
+
+	// Assert
+	assert.EqualError(
+		t,
+		err,
+		"`animal` consts don't match the enum declarations: missing [Chic\tken, Cat], extra [Calf], "+
+			"mismatched [`Cow` is Animal(7)]")
+}
+
+func TestMustMatchNames_OnRenamedConsts_ThenPanic(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		MustMatchNames(map[string]testAnimal{"Chic\tken": chicken, "Dog": dog, "Kat": cat, "Cow": cow})
+	})
+}
+
+func TestMustMatch_OnMissingConsts_ThenPanic(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		MustMatch(dog)
+	})
+}
@@ -1,41 +1,100 @@
 package gnum
 
 import (
+	"errors"
 	"fmt"
 	"github.com/joelboim/gnum/infra"
 	"reflect"
+	"slices"
 	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
 )
 
-var globalConfig = &config{}
+var (
+	// globalConfig is replaced as a whole by SetOptions, so it's never read while being modified.
+	globalConfig atomic.Pointer[config]
+	enumOptions  = newEnumOptions()
+)
 
 type config struct {
-	caseInsensitive bool
-	parseCallback   func(value string) string
-	stringCallback  func(value string) string
+	caseInsensitive   bool
+	jsonFormat        JSONFormatMode
+	namingInsensitive bool
+	numbering         infra.Numbering
+	parseCallback     func(value string) string
+	sqlStorage        SQLStorageMode
+	strictString      bool
+	stringCallback    func(value string) string
+	typeName          string
 }
 
+// SQLStorageMode decides how an Enum is stored by driver.Valuer.
+type SQLStorageMode int
+
+const (
+	// SQLStorageValue stores the enum int value.
+	SQLStorageValue SQLStorageMode = iota
+	// SQLStorageName stores the enum name.
+	SQLStorageName
+)
+
 type enumMetadata struct {
+	config   config
+	typeName string
+	// denseEnumIndexes maps value-enumValueOffset to the value index in the sorted slices,
+	// or -1 for a missing value. It's nil when the values are too sparse for a slice lookup.
+	denseEnumIndexes           []int
+	enumValueOffset            int
+	enumAliasToEnumValue       map[string]int
+	enumValueToEnumDescription map[int]string
+	enumValueToEnumLabel       map[int]string
 	enumNameLoweredToEnumValue map[string]int
-	enumNameToEnumValue        map[string]int
-	enumValueToEnumName        map[int]string
-	enumValueToEnumString      map[int]string
-	joinedEnumNames            string
-	sortedEnumNames            []string
-	sortedEnumStrings          []string
-	sortedEnumValues           []int
+	// enumNameNormalizedToEnumValue is only built when NamingInsensitive(true) is set.
+	enumNameNormalizedToEnumValue map[string]int
+	enumNameToEnumValue           map[string]int
+	enumValueToEnumName           map[int]string
+	enumValueToEnumString         map[int]string
+	sortedEnumDescriptions        []string
+	sortedEnumLabels              []string
+	sortedEnumNames               []string
+	sortedEnumStrings             []string
+	sortedEnumValues              []int
 }
 
 // Option callback function that sets specific value on an *config instance.
 type Option func(config *config)
 
 // SetOptions Sets multiple Option on the global scope.
+// It's safe to call concurrently with other SetOptions calls and with enums in use,
+// enums used after it returns are built with the new options.
 func SetOptions(options ...Option) {
-	for _, option := range options {
-		option(globalConfig)
+	for {
+		currentConfig := globalConfig.Load()
+		var newConfig config
+		if currentConfig != nil {
+			newConfig = *currentConfig
+		}
+
+		for _, option := range options {
+			option(&newConfig)
+		}
+
+		if globalConfig.CompareAndSwap(currentConfig, &newConfig) {
+			break
+		}
 	}
 
-	cache = newEnumCache()
+	cache.Reset()
+}
+
+// Configure Sets multiple Option on the T scope only.
+// The options take precedence over the global ones and the ones declared on the T config field.
+func Configure[T Enumer[T]](options ...Option) {
+	enumOptions.Add(reflect.TypeOf(*new(T)), options...)
+
+	cache.Reset()
 }
 
 // StringCallback will be applied for each Enum.String call and Enum.Strings.
@@ -53,6 +112,30 @@ func CaseInsensitive(caseInsensitive bool) Option {
 	}
 }
 
+// SQLStorage sets whether driver.Valuer stores the enum value or the enum name,
+// sql.Scanner accepts both regardless of the mode.
+func SQLStorage(mode SQLStorageMode) Option {
+	return func(c *config) {
+		c.sqlStorage = mode
+	}
+}
+
+// StrictString - when set to true, Enum.Name and Enum.String will panic
+// on a value that isn't part of the enum mapping instead of returning "Type(value)".
+func StrictString(strictString bool) Option {
+	return func(c *config) {
+		c.strictString = strictString
+	}
+}
+
+// TypeName sets the enum type name of values that aren't part of the enum mapping, e.g., "Color(7)".
+// By default, it's the underline T type name with an upper case first letter.
+func TypeName(typeName string) Option {
+	return func(c *config) {
+		c.typeName = typeName
+	}
+}
+
 // ParseCallback will be applied for each Enum.Parse call and Enum.UnmarshalText.
 func ParseCallback(callback func(value string) string) Option {
 	return func(c *config) {
@@ -61,34 +144,127 @@ func ParseCallback(callback func(value string) string) Option {
 }
 
 // newEnumMetadata return a new *enumMetadata, based on the provided T
-// and applies the globalConfig.
-func newEnumMetadata[T any]() *enumMetadata {
-	enumNameToEnumValue := getEnumNameToEnumValue[T]()
+// and applies the globalConfig, T config field options and the given enum options, in that order.
+// It panics if T isn't a valid enum definition.
+func newEnumMetadata[T any](options ...Option) *enumMetadata {
+	return mustEnumMetadata(tryNewEnumMetadata[T](options...))
+}
+
+// tryNewEnumMetadata is like newEnumMetadata but returns all the T definition problems instead of panicking.
+func tryNewEnumMetadata[T any](options ...Option) (*enumMetadata, error) {
+	var problems enumProblems
+	enumConfig := newEnumConfig(append(getEnumConfigOptions[T](&problems), options...))
+	return buildEnumDefinition[T](enumConfig, &problems)
+}
+
+// newFlagEnumMetadata return a new *enumMetadata for a FlagEnum, based on the provided T
+// and applies the globalConfig, T config field options and the given enum options, in that order.
+// Unless T config field declares otherwise, the flags are numbered by powers of two.
+// It panics if T isn't a valid enum definition.
+func newFlagEnumMetadata[T any](options ...Option) *enumMetadata {
+	return mustEnumMetadata(tryNewFlagEnumMetadata[T](options...))
+}
+
+// tryNewFlagEnumMetadata is like newFlagEnumMetadata but returns all the T definition problems instead of panicking.
+func tryNewFlagEnumMetadata[T any](options ...Option) (*enumMetadata, error) {
+	var problems enumProblems
+	enumConfig := newEnumConfig(append(append([]Option{shiftNumbering(true)}, getEnumConfigOptions[T](&problems)...), options...))
+	return buildEnumDefinition[T](enumConfig, &problems)
+}
+
+// buildEnumDefinition builds the T *enumMetadata with enumConfig, returning the joined problems if there are any.
+func buildEnumDefinition[T any](enumConfig config, problems *enumProblems) (*enumMetadata, error) {
+	enumNameToEnumValue, enumNameToEnumTag := getEnumDeclarations[T](enumConfig.numbering, problems)
+	metadata := buildEnumMetadata(
+		getEnumTypeName[T](),
+		enumNameToEnumValue,
+		enumNameToEnumTag,
+		enumConfig,
+		problems)
+	if err := problems.err(); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// mustEnumMetadata panics with err if it isn't nil, otherwise it returns metadata.
+func mustEnumMetadata(metadata *enumMetadata, err error) *enumMetadata {
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return metadata
+}
+
+// enumProblems collects the problems of an enum definition, so they can be reported together.
+type enumProblems []error
+
+func (p *enumProblems) add(format string, args ...any) {
+	*p = append(*p, fmt.Errorf(format, args...))
+}
+
+// err returns the joined problems, or nil if there are none.
+func (p *enumProblems) err() error {
+	return errors.Join(*p...)
+}
+
+// newEnumConfig returns a copy of the globalConfig with the given options applied on top of it.
+func newEnumConfig(options []Option) config {
+	var enumConfig config
+	if currentConfig := globalConfig.Load(); currentConfig != nil {
+		enumConfig = *currentConfig
+	}
+
+	for _, option := range options {
+		option(&enumConfig)
+	}
+
+	return enumConfig
+}
+
+// buildEnumMetadata builds the *enumMetadata lookups from an enum name to enum value mapping
+// and an enum name to enum tag mapping.
+func buildEnumMetadata(
+	typeName string,
+	enumNameToEnumValue map[string]int,
+	enumNameToEnumTag map[string]*enumTag,
+	enumConfig config,
+	problems *enumProblems) *enumMetadata {
+
 	metadata := &enumMetadata{
+		config:                     enumConfig,
+		typeName:                   typeName,
+		enumAliasToEnumValue:       make(map[string]int),
+		enumValueToEnumDescription: make(map[int]string),
+		enumValueToEnumLabel:       make(map[int]string),
 		enumNameLoweredToEnumValue: make(map[string]int),
 		enumNameToEnumValue:        enumNameToEnumValue,
 		enumValueToEnumName:        make(map[int]string),
 		enumValueToEnumString:      make(map[int]string),
+		sortedEnumDescriptions:     make([]string, 0, len(enumNameToEnumValue)),
+		sortedEnumLabels:           make([]string, 0, len(enumNameToEnumValue)),
 		sortedEnumNames:            make([]string, 0, len(enumNameToEnumValue)),
 		sortedEnumStrings:          make([]string, 0, len(enumNameToEnumValue)),
 		sortedEnumValues:           make([]int, 0, len(enumNameToEnumValue)),
 	}
 
 	for enumName, enumValue := range enumNameToEnumValue {
-		if duplicateEnumName, ok := metadata.enumValueToEnumName[enumValue]; ok {
-			panic(fmt.Sprintf(
-				"`%s` and `%s` have the same value",
-				duplicateEnumName,
-				enumName))
+		enumString := enumName
+		if metadata.config.stringCallback != nil {
+			enumString = metadata.config.stringCallback(enumName)
 		}
 
-		enumString := enumName
-		if globalConfig.stringCallback != nil {
-			enumString = globalConfig.stringCallback(enumName)
+		enumDescription, enumLabel := "", enumName
+		if enumTag := enumNameToEnumTag[enumName]; enumTag != nil {
+			enumDescription = infra.GetPointerValue(enumTag.Description, enumDescription)
+			enumLabel = infra.GetPointerValue(enumTag.Label, enumLabel)
 		}
 
 		metadata.enumValueToEnumName[enumValue] = enumName
 		metadata.enumValueToEnumString[enumValue] = enumString
+		metadata.enumValueToEnumDescription[enumValue] = enumDescription
+		metadata.enumValueToEnumLabel[enumValue] = enumLabel
 
 		metadata.enumNameLoweredToEnumValue[strings.ToLower(enumName)] = enumValue
 
@@ -103,39 +279,304 @@ func newEnumMetadata[T any]() *enumMetadata {
 			&metadata.sortedEnumStrings,
 			enumString,
 			sortedIndex)
+		infra.InsertToSliceByIndex(
+			&metadata.sortedEnumDescriptions,
+			enumDescription,
+			sortedIndex)
+		infra.InsertToSliceByIndex(
+			&metadata.sortedEnumLabels,
+			enumLabel,
+			sortedIndex)
 	}
 
-	metadata.joinedEnumNames = strings.Join(metadata.sortedEnumNames, ", ")
+	metadata.denseEnumIndexes, metadata.enumValueOffset = getDenseEnumIndexes(metadata.sortedEnumValues)
+
+	for _, enumName := range metadata.sortedEnumNames {
+		enumTag := enumNameToEnumTag[enumName]
+		if enumTag == nil {
+			continue
+		}
+
+		for _, enumAlias := range enumTag.Aliases {
+			if err := metadata.addEnumAlias(enumAlias, enumName); err != nil {
+				*problems = append(*problems, err)
+			}
+		}
+	}
+
+	if enumConfig.namingInsensitive {
+		metadata.addNormalizedEnumNames(enumNameToEnumTag)
+	}
 
 	return metadata
 }
 
-// getEnumNameToEnumValue crates a mapping of enum names to enum values based on the T and its tags.
-func getEnumNameToEnumValue[T any]() map[string]int {
-	enumNameToEnumValue := make(map[string]int)
-	nextEnumValue := 0
+// addNormalizedEnumNames maps the normalized enum names and aliases to the enum values.
+// A normalized name shared by different enum values is ambiguous and isn't mapped,
+// so names and aliases with that spelling only parse by their exact spelling.
+func (m *enumMetadata) addNormalizedEnumNames(enumNameToEnumTag map[string]*enumTag) {
+	m.enumNameNormalizedToEnumValue = make(map[string]int, len(m.sortedEnumNames))
+	ambiguousNormalizedNames := make(map[string]bool)
+	addNormalizedName := func(name string, value int) {
+		normalizedName := normalizeName(name)
+		if existingValue, ok := m.enumNameNormalizedToEnumValue[normalizedName]; ok && existingValue != value {
+			ambiguousNormalizedNames[normalizedName] = true
+		}
+
+		m.enumNameNormalizedToEnumValue[normalizedName] = value
+	}
+
+	for i, enumName := range m.sortedEnumNames {
+		addNormalizedName(enumName, m.sortedEnumValues[i])
+		if enumTag := enumNameToEnumTag[enumName]; enumTag != nil {
+			for _, enumAlias := range enumTag.Aliases {
+				addNormalizedName(enumAlias, m.sortedEnumValues[i])
+			}
+		}
+	}
+
+	for normalizedName := range ambiguousNormalizedNames {
+		delete(m.enumNameNormalizedToEnumValue, normalizedName)
+	}
+}
+
+// addEnumAlias maps enumAlias to the value of enumName,
+// it returns an error if enumAlias is already an enum name or an alias.
+func (m *enumMetadata) addEnumAlias(enumAlias string, enumName string) error {
+	if _, ok := m.enumNameToEnumValue[enumAlias]; ok {
+		return fmt.Errorf("`%s` alias of `%s` is already an enum name", enumAlias, enumName)
+	}
+
+	if duplicateEnumValue, ok := m.enumAliasToEnumValue[enumAlias]; ok {
+		return fmt.Errorf(
+			"`%s` and `%s` have the same alias `%s`",
+			m.enumValueToEnumName[duplicateEnumValue],
+			enumName,
+			enumAlias)
+	}
+
+	enumValue := m.enumNameToEnumValue[enumName]
+	m.enumAliasToEnumValue[enumAlias] = enumValue
+
+	loweredEnumAlias := strings.ToLower(enumAlias)
+	if _, ok := m.enumNameLoweredToEnumValue[loweredEnumAlias]; !ok {
+		m.enumNameLoweredToEnumValue[loweredEnumAlias] = enumValue
+	}
+
+	return nil
+}
+
+// index returns the index of value in the sorted slices, or false if value isn't part of the mapping.
+// Dense values are looked up by a slice, sparse values (e.g., flags) by a binary search.
+func (m *enumMetadata) index(value int) (int, bool) {
+	if m.denseEnumIndexes != nil {
+		denseIndex := uint(value - m.enumValueOffset)
+		if denseIndex >= uint(len(m.denseEnumIndexes)) {
+			return -1, false
+		}
+
+		index := m.denseEnumIndexes[denseIndex]
+		return index, index >= 0
+	}
+
+	return slices.BinarySearch(m.sortedEnumValues, value)
+}
+
+// parse returns the enum value of the given name, after applying the enum config.
+// NamingInsensitive(true) takes precedence over CaseInsensitive(true), as it ignores the case as well,
+// a name that isn't found falls back to the case-insensitive lookup if set, then to the exact names and aliases.
+func (m *enumMetadata) parse(name string) (int, error) {
+	if m.config.parseCallback != nil {
+		name = m.config.parseCallback(name)
+	}
+
+	var (
+		value int
+		ok    bool
+	)
+	if m.config.namingInsensitive {
+		value, ok = m.enumNameNormalizedToEnumValue[normalizeName(name)]
+	}
+
+	if !ok && m.config.caseInsensitive {
+		value, ok = m.enumNameLoweredToEnumValue[strings.ToLower(name)]
+	}
+
+	if !ok {
+		value, ok = m.enumNameToEnumValue[name]
+	}
+
+	if !ok {
+		value, ok = m.enumAliasToEnumValue[name]
+	}
+
+	if !ok {
+		return -1, newParseError(name, m.typeName, m.sortedEnumNames)
+	}
+
+	return value, nil
+}
+
+// unknownString returns the "Type(value)" representation of a value that isn't part of the mapping,
+// like stringer does, or panics with err if StrictString(true) is set.
+func (m *enumMetadata) unknownString(value int, err error) string {
+	if m.config.strictString {
+		panic(err.Error())
+	}
+
+	return m.formatUnknown(value)
+}
+
+// formatUnknown returns the "Type(value)" representation of a value that isn't part of the mapping,
+// using the TypeName option, or the type name with an upper case first letter.
+func (m *enumMetadata) formatUnknown(value int) string {
+	typeName := m.config.typeName
+	if typeName == "" {
+		typeName = exportedName(m.typeName)
+	}
+
+	return fmt.Sprintf("%s(%d)", typeName, value)
+}
+
+// exportedName returns name with an upper case first letter, e.g., "color" for the `Color` alias of
+// `Enum[struct{ Red color }]` becomes "Color".
+func exportedName(name string) string {
+	first, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(first)) + name[size:]
+}
+
+// joinFlags returns the "|" joined representations of each single bit flag set in value,
+// using valueToRepresentation for the lookup.
+// A value that matches a declared flag exactly (e.g., a zero value or a combined declaration)
+// is returned as is. ok is false when value has bits that aren't declared.
+func (m *enumMetadata) joinFlags(value int, valueToRepresentation map[int]string) (joined string, ok bool) {
+	if representation, ok := valueToRepresentation[value]; ok {
+		return representation, true
+	}
+
+	var (
+		representations []string
+		remaining       = value
+	)
+	for _, flagValue := range m.sortedEnumValues {
+		if flagValue <= 0 || flagValue&(flagValue-1) != 0 || remaining&flagValue == 0 {
+			continue
+		}
+
+		representations = append(representations, valueToRepresentation[flagValue])
+		remaining &^= flagValue
+	}
+
+	if remaining != 0 {
+		return "", false
+	}
+
+	return strings.Join(representations, flagSeparator), true
+}
+
+// maxDenseEnumSpanFactor is the maximal ratio between the values span and the number of values
+// for which a slice lookup is used.
+const maxDenseEnumSpanFactor = 4
+
+// getDenseEnumIndexes returns a slice mapping each value in the span of sortedEnumValues,
+// offset by the returned minimal value, to its index in sortedEnumValues or -1 if it's missing.
+// It returns nil if the values are too sparse.
+func getDenseEnumIndexes(sortedEnumValues []int) (denseEnumIndexes []int, enumValueOffset int) {
+	if len(sortedEnumValues) == 0 {
+		return nil, 0
+	}
+
+	enumValueOffset = sortedEnumValues[0]
+	span := uint(sortedEnumValues[len(sortedEnumValues)-1] - enumValueOffset)
+	if span >= uint(maxDenseEnumSpanFactor*len(sortedEnumValues)) {
+		return nil, 0
+	}
+
+	denseEnumIndexes = make([]int, span+1)
+	for i := range denseEnumIndexes {
+		denseEnumIndexes[i] = -1
+	}
+
+	for i, value := range sortedEnumValues {
+		denseEnumIndexes[value-enumValueOffset] = i
+	}
+
+	return denseEnumIndexes, enumValueOffset
+}
+
+// getEnumTypeName returns the type name of the first enum declaration of T.
+func getEnumTypeName[T any]() string {
 	for _, field := range reflect.VisibleFields(reflect.TypeOf(*new(T))) {
-		enumTag := newEnumTag(field)
-		if enumTag == nil {
-			enumNameToEnumValue[field.Name] = nextEnumValue
-			nextEnumValue += 1
+		if !isEnumConfigField(field) {
+			return field.Type.Name()
+		}
+	}
+
+	return ""
+}
+
+// getEnumConfigOptions returns the options declared on the config fields of T.
+func getEnumConfigOptions[T any](problems *enumProblems) []Option {
+	var options []Option
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(*new(T))) {
+		if isEnumConfigField(field) {
+			options = append(options, newEnumConfigTagOptions(field, problems)...)
+		}
+	}
+
+	return options
+}
+
+// getEnumDeclarations crates a mapping of enum names to enum values and of enum names to enum tags
+// based on the T and its tags, fields without a tag aren't part of the enum tags mapping.
+// Fields without an explicit value are numbered by numbering, continuing after the last explicit value.
+func getEnumDeclarations[T any](
+	numbering infra.Numbering,
+	problems *enumProblems) (enumNameToEnumValue map[string]int, enumNameToEnumTag map[string]*enumTag) {
+
+	var (
+		enumNames          []string
+		explicitEnumValues []*int
+	)
+	enumNameToEnumTag = make(map[string]*enumTag)
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(*new(T))) {
+		if isEnumConfigField(field) {
 			continue
 		}
 
-		enumName := infra.GetPointerValue(enumTag.Name, field.Name)
-		if _, ok := enumNameToEnumValue[enumName]; ok {
-			panic(fmt.Sprintf("duplicate enum name - `%s`", enumName))
+		enumName := field.Name
+		var explicitEnumValue *int
+		if enumTag := newEnumTag(field, problems); enumTag != nil {
+			enumName = infra.GetPointerValue(enumTag.Name, field.Name)
+			explicitEnumValue = enumTag.Value
+			enumNameToEnumTag[enumName] = enumTag
+		}
+
+		enumNames = append(enumNames, enumName)
+		explicitEnumValues = append(explicitEnumValues, explicitEnumValue)
+	}
+
+	enumValues, err := numbering.GetValues(explicitEnumValues)
+	if err != nil {
+		problems.add("%s - `%s`", err, enumNames[len(enumValues)])
+	}
+
+	enumNameToEnumValue = make(map[string]int, len(enumValues))
+	enumValueToEnumName := make(map[int]string, len(enumValues))
+	for i, enumValue := range enumValues {
+		if _, ok := enumNameToEnumValue[enumNames[i]]; ok {
+			problems.add("duplicate enum name - `%s`", enumNames[i])
+			continue
 		}
 
-		if enumTag.Value == nil {
-			enumNameToEnumValue[enumName] = nextEnumValue
-			nextEnumValue += 1
+		if duplicateEnumName, ok := enumValueToEnumName[enumValue]; ok {
+			problems.add("`%s` and `%s` have the same value", duplicateEnumName, enumNames[i])
 			continue
 		}
 
-		enumNameToEnumValue[enumName] = *enumTag.Value
-		nextEnumValue += *enumTag.Value
+		enumNameToEnumValue[enumNames[i]] = enumValue
+		enumValueToEnumName[enumValue] = enumNames[i]
 	}
 
-	return enumNameToEnumValue
+	return enumNameToEnumValue, enumNameToEnumTag
 }
@@ -312,3 +312,341 @@ func (s *enumMetadataTestSuite) TestEnums_OnCustomEnumMetadataAndMultipleEnums_T
 	// Assert
 	assert.Equal(s.T(), []testColor{green, red, blue, yellow}, actualEnums)
 }
+
+func TestEnumMetadata_OnConfigFieldTag_ThenApplyOnlyToEnum(t *testing.T) {
+	// Arrange
+	type (
+		vehicle_ int
+		enum     = Enum[struct {
+			_ struct{} `gnum:"case_insensitive,sql=name"`
+			Car,
+			Bus vehicle_
+		}]
+	)
+
+	// Act
+	actualEnum, err := Parse[enum]("BUS")
+	require.NoError(t, err)
+	_, err = Parse[testAnimal]("COW")
+
+	// Assert
+	assert.Equal(t, enum(1), actualEnum)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"Car", "Bus"}, Names[enum]())
+	assert.Equal(t, "vehicle_", Type[enum]())
+}
+
+func TestEnumMetadata_OnTypeConfigFieldTag_ThenRenderUnknownValuesWithTypeName(t *testing.T) {
+	// Arrange
+	type (
+		vehicle_ int
+		enum     = Enum[struct {
+			_ struct{} `gnum:"type=Vehicle"`
+			Car,
+			Bus vehicle_
+		}]
+	)
+
+	// Act
+	actualString := enum(7).String()
+
+	// Assert
+	assert.Equal(t, "Vehicle(7)", actualString)
+	assert.Equal(t, "vehicle_", Type[enum]())
+}
+
+func TestEnumMetadata_OnConfigureAndConfigFieldTag_ThenConfigureTakesPrecedence(t *testing.T) {
+	// Arrange
+	type (
+		vehicle_ int
+		enum     = Enum[struct {
+			_ struct{} `gnum:"case_insensitive"`
+			Car,
+			Bus vehicle_
+		}]
+	)
+
+	SetOptions(SQLStorage(SQLStorageName))
+	defer SetOptions(SQLStorage(SQLStorageValue))
+
+	// Act
+	Configure[enum](CaseInsensitive(false))
+	_, err := Parse[enum]("BUS")
+	actualValue, valueErr := enum(1).Value()
+	require.NoError(t, valueErr)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "Bus", actualValue)
+}
+
+func TestEnumMetadata_OnUnknownConfigFieldTag_ThenPanic(t *testing.T) {
+	// Arrange
+	type (
+		vehicle_ int
+		enum     = Enum[struct {
+			_ struct{} `gnum:"case_sensitive"`
+			Car,
+			Bus vehicle_
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		Names[enum]()
+	})
+}
+
+func TestEnumMetadata_OnInvalidConfigFieldTagValue_ThenPanic(t *testing.T) {
+	// Arrange
+	type (
+		vehicle_ int
+		enum     = Enum[struct {
+			_ struct{} `gnum:"sql=text"`
+			Car,
+			Bus vehicle_
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		Names[enum]()
+	})
+}
+
+func TestEnumMetadata_OnAliasTag_ThenParseAliasAndReturnCanonicalName(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			Red   color_ `gnum:"alias=crimson|scarlet"`
+			Blue  color_ `gnum:"name=b_l_u_e,alias=navy"`
+			Green color_
+		}]
+	)
+
+	actualEnum := new(enum)
+
+	// Act
+	crimson, err := Parse[enum]("crimson")
+	require.NoError(t, err)
+	navy, err := Parse[enum]("navy")
+	require.NoError(t, err)
+	err = actualEnum.UnmarshalText([]byte("scarlet"))
+	require.NoError(t, err)
+	actualText, err := actualEnum.MarshalText()
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, enum(0), crimson)
+	assert.Equal(t, enum(1), navy)
+	assert.Equal(t, "b_l_u_e", navy.Name())
+	assert.Equal(t, "Red", string(actualText))
+	assert.Equal(t, []string{"Red", "b_l_u_e", "Green"}, Names[enum]())
+}
+
+func TestEnumMetadata_OnAliasTagAndCaseInsensitive_ThenParseAlias(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			_     struct{} `gnum:"case_insensitive"`
+			Red   color_   `gnum:"alias=crimson"`
+			Green color_
+		}]
+	)
+
+	// Act
+	actualEnum, err := Parse[enum]("CRIMSON")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, enum(0), actualEnum)
+}
+
+func TestEnumMetadata_OnAliasCollidesWithName_ThenPanic(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			Red   color_ `gnum:"alias=Green"`
+			Green color_
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.PanicsWithValue(t, "`Green` alias of `Red` is already an enum name", func() {
+		Names[enum]()
+	})
+}
+
+func TestEnumMetadata_OnAliasCollidesWithAlias_ThenPanic(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			Red   color_ `gnum:"alias=dark"`
+			Green color_ `gnum:"alias=light|dark"`
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.PanicsWithValue(t, "`Red` and `Green` have the same alias `dark`", func() {
+		Names[enum]()
+	})
+}
+
+func TestEnumMetadata_OnEmptyAlias_ThenPanic(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			Red   color_ `gnum:"alias=crimson|"`
+			Green color_
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		Names[enum]()
+	})
+}
+
+func TestEnumMetadata_OnDescAndLabelTags_ThenReturnDescriptionsAndLabels(t *testing.T) {
+	// Arrange
+	type (
+		status_ int
+		enum    = Enum[struct {
+			Active   status_ `gnum:"desc=The account can sign in,label=Active account"`
+			Disabled status_ `gnum:"name=disabled,label=Disabled account"`
+			Pending  status_
+		}]
+	)
+
+	// Act
+	actualDescriptions := Descriptions[enum]()
+	actualLabels := Labels[enum]()
+
+	// Assert
+	assert.Equal(t, []string{"The account can sign in", "", ""}, actualDescriptions)
+	assert.Equal(t, []string{"Active account", "Disabled account", "Pending"}, actualLabels)
+	assert.Equal(t, "The account can sign in", enum(0).Description())
+	assert.Equal(t, "Disabled account", enum(1).Label())
+	assert.Equal(t, "disabled", enum(1).Name())
+	assert.Equal(t, "disabled", enum(1).String())
+	assert.Equal(t, "", enum(3).Description())
+	assert.Equal(t, "Status_(3)", enum(3).Label())
+}
+
+func TestEnumMetadata_OnFlagDescAndLabelTags_ThenReturnJoinedLabels(t *testing.T) {
+	// Arrange
+	type (
+		permission_ int
+		flag        = FlagEnum[struct {
+			Read  permission_ `gnum:"label=R,desc=Can read"`
+			Write permission_ `gnum:"label=W"`
+		}]
+	)
+
+	// Act
+	actualLabel := (flag(1) | flag(2)).Label()
+
+	// Assert
+	assert.Equal(t, "R|W", actualLabel)
+	assert.Equal(t, "Can read", flag(1).Description())
+	assert.Equal(t, "", (flag(1) | flag(2)).Description())
+}
+
+func TestEnumMetadata_OnQuotedTagValues_ThenUseUnquotedValues(t *testing.T) {
+	// Arrange
+	type (
+		size_ int
+		enum  = Enum[struct {
+			Small size_ `gnum:"name='s,m',value=3,desc='Up to 1,000 items'"`
+			Large size_
+		}]
+	)
+
+	// Act
+	actualEnum, err := Parse[enum]("s,m")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, enum(3), actualEnum)
+	assert.Equal(t, "Up to 1,000 items", actualEnum.Description())
+	assert.Equal(t, []int{3, 4}, Values[enum]())
+}
+
+func TestEnumMetadata_OnMalformedTag_ThenPanicWithFieldName(t *testing.T) {
+	// Arrange
+	type (
+		size_ int
+		enum  = Enum[struct {
+			Small size_
+			Large size_ `gnum:"name=big,vaule=2"`
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.PanicsWithValue(t, "invalid gnum tag of `Large` field: unknown key `vaule` - `name=big,vaule=2`", func() {
+		Names[enum]()
+	})
+}
+
+func TestGetDenseEnumIndexes_OnDenseValues_ThenReturnIndexesByOffset(t *testing.T) {
+	// Arrange
+	// Act
+	actualIndexes, actualOffset := getDenseEnumIndexes([]int{-1, 0, 2})
+
+	// Assert
+	assert.Equal(t, []int{0, 1, -1, 2}, actualIndexes)
+	assert.Equal(t, -1, actualOffset)
+}
+
+func TestGetDenseEnumIndexes_OnSparseValues_ThenReturnNil(t *testing.T) {
+	// Arrange
+	// Act
+	actualIndexes, _ := getDenseEnumIndexes([]int{1, 100, 1000000})
+
+	// Assert
+	assert.Nil(t, actualIndexes)
+}
+
+func TestEnumMetadataIndex_OnDenseAndSparseValues_ThenReturnSortedIndex(t *testing.T) {
+	// Arrange
+	type (
+		size_  int
+		dense  = Enum[struct{ Small, Medium, Large size_ }]
+		sparse = Enum[struct {
+			Small  size_ `gnum:"value=-1000"`
+			Medium size_
+			Large  size_ `gnum:"value=1000"`
+		}]
+	)
+
+	for _, metadata := range []*enumMetadata{dense(0).getConfig(), sparse(0).getConfig()} {
+		for i, value := range metadata.sortedEnumValues {
+			// Act
+			actualIndex, ok := metadata.index(value)
+
+			// Assert
+			assert.True(t, ok)
+			assert.Equal(t, i, actualIndex)
+		}
+
+		// Act
+		_, ok := metadata.index(3)
+
+		// Assert
+		assert.False(t, ok)
+	}
+
+	assert.Equal(t, "Medium", sparse(-999).Name())
+	assert.Equal(t, "Size_(-998)", sparse(-998).String())
+}
@@ -0,0 +1,162 @@
+package gnum
+
+import (
+	"strings"
+	"unicode"
+)
+
+// namingConventions maps the `string` enum config tag values to their naming convention,
+// e.g., `_ struct{} gnum:"string=snake"`.
+var namingConventions = map[string]func(name string) string{
+	"snake":           SnakeCase,
+	"kebab":           KebabCase,
+	"screaming_snake": ScreamingSnake,
+	"lower_camel":     LowerCamel,
+	"title":           TitleWords,
+}
+
+// SnakeCase converts a name to snake case, e.g., "DarkRed" and "HTTPServer" to "dark_red" and "http_server".
+// It can be used as a StringCallback.
+func SnakeCase(name string) string {
+	return joinWords(splitWords(name), "_", strings.ToLower)
+}
+
+// KebabCase converts a name to kebab case, e.g., "DarkRed" and "HTTPServer" to "dark-red" and "http-server".
+// It can be used as a StringCallback.
+func KebabCase(name string) string {
+	return joinWords(splitWords(name), "-", strings.ToLower)
+}
+
+// ScreamingSnake converts a name to screaming snake case, e.g., "DarkRed" to "DARK_RED".
+// It can be used as a StringCallback.
+func ScreamingSnake(name string) string {
+	return joinWords(splitWords(name), "_", strings.ToUpper)
+}
+
+// LowerCamel converts a name to lower camel case, e.g., "DarkRed" and "HTTPServer" to "darkRed" and "httpServer".
+// Acronyms that aren't the first word are kept upper cased, e.g., "UserID" to "userID".
+// It can be used as a StringCallback.
+func LowerCamel(name string) string {
+	words := splitWords(name)
+	titleWord := newTitleWord(name)
+	for i, word := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(word)
+		} else {
+			words[i] = titleWord(word)
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// TitleWords converts a name to space separated title cased words, e.g., "DarkRed" and "dark_red" to "Dark Red".
+// Acronyms are kept upper cased, e.g., "HTTPServer" to "HTTP Server".
+// It can be used as a StringCallback.
+func TitleWords(name string) string {
+	return joinWords(splitWords(name), " ", newTitleWord(name))
+}
+
+// NamingInsensitive - when set to true, Enum.Parse and Enum.UnmarshalText accept a name
+// in any naming convention, e.g., "dark_red", "dark-red", "DARK_RED", "darkRed" and "Dark Red" for "DarkRed".
+// Names or aliases of different members that share a spelling in some convention, e.g., "HTTPCode" and "HttpCode",
+// never fail the enum, they only parse by their exact spelling.
+func NamingInsensitive(namingInsensitive bool) Option {
+	return func(c *config) {
+		c.namingInsensitive = namingInsensitive
+	}
+}
+
+// normalizeName returns the lower cased words of name without separators, e.g., "darkred" for "Dark_Red",
+// so all the naming conventions of a name have the same normalized name.
+func normalizeName(name string) string {
+	return joinWords(splitWords(name), "", strings.ToLower)
+}
+
+// newTitleWord returns a function that upper cases the first letter of a word of name and lower cases the rest,
+// unless the word is an acronym. The words of an all upper cased name, e.g., "DARK_RED", aren't acronyms.
+func newTitleWord(name string) func(word string) string {
+	keepAcronyms := strings.IndexFunc(name, unicode.IsLower) >= 0
+	return func(word string) string {
+		if keepAcronyms && isAcronym(word) {
+			return word
+		}
+
+		runes := []rune(strings.ToLower(word))
+		runes[0] = unicode.ToUpper(runes[0])
+		return string(runes)
+	}
+}
+
+// isAcronym reports whether word has more than one letter, all of them upper cased, e.g., "ID" or "HTTP2".
+func isAcronym(word string) bool {
+	letters := 0
+	for _, r := range word {
+		if unicode.IsLower(r) {
+			return false
+		}
+
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+
+	return letters > 1
+}
+
+func joinWords(words []string, separator string, convert func(word string) string) string {
+	for i, word := range words {
+		words[i] = convert(word)
+	}
+
+	return strings.Join(words, separator)
+}
+
+// splitWords splits name to its words, on any character that isn't a letter or a digit
+// and on case changes, e.g., "HTTPServer_v2" to "HTTP", "Server" and "v2".
+// An upper cased run is an acronym, that ends before an upper case letter followed by a lower case one.
+func splitWords(name string) []string {
+	var (
+		words []string
+		runes = []rune(name)
+		start = -1
+	)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+
+			continue
+		}
+
+		if start >= 0 && isWordBoundary(runes, i) {
+			words = append(words, string(runes[start:i]))
+			start = -1
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
+
+// isWordBoundary reports whether a new word starts at runes[i], given runes[i-1] is part of a word.
+func isWordBoundary(runes []rune, i int) bool {
+	previous, current := runes[i-1], runes[i]
+	switch {
+	case unicode.IsUpper(current) && (unicode.IsLower(previous) || unicode.IsDigit(previous)):
+		return true
+	case unicode.IsUpper(current) && unicode.IsUpper(previous):
+		return i+1 < len(runes) && unicode.IsLower(runes[i+1])
+	default:
+		return false
+	}
+}
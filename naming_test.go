@@ -0,0 +1,190 @@
+package gnum
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNamingConventions_OnNames_ThenConvert(t *testing.T) {
+	tests := []struct {
+		name           string
+		snake          string
+		kebab          string
+		screamingSnake string
+		lowerCamel     string
+		title          string
+	}{
+		{"DarkRed", "dark_red", "dark-red", "DARK_RED", "darkRed", "Dark Red"},
+		{"HTTPServer", "http_server", "http-server", "HTTP_SERVER", "httpServer", "HTTP Server"},
+		{"UserID", "user_id", "user-id", "USER_ID", "userID", "User ID"},
+		{"dark_red", "dark_red", "dark-red", "DARK_RED", "darkRed", "Dark Red"},
+		{"DARK_RED", "dark_red", "dark-red", "DARK_RED", "darkRed", "Dark Red"},
+		{"Base64Encode", "base64_encode", "base64-encode", "BASE64_ENCODE", "base64Encode", "Base64 Encode"},
+		{"HTTP2Server", "http2_server", "http2-server", "HTTP2_SERVER", "http2Server", "HTTP2 Server"},
+		{"Red", "red", "red", "RED", "red", "Red"},
+		{"", "", "", "", "", ""},
+	}
+	for _, test := range tests {
+		// Arrange
+		// Act
+		// Assert
+		assert.Equal(t, test.snake, SnakeCase(test.name), test.name)
+		assert.Equal(t, test.kebab, KebabCase(test.name), test.name)
+		assert.Equal(t, test.screamingSnake, ScreamingSnake(test.name), test.name)
+		assert.Equal(t, test.lowerCamel, LowerCamel(test.name), test.name)
+		assert.Equal(t, test.title, TitleWords(test.name), test.name)
+	}
+}
+
+func TestNamingInsensitive_OnAnyNamingConvention_ThenParseEnum(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			Red,
+			DarkRed color_
+			HTTPBlue color_ `gnum:"alias=SkyBlue"`
+		}]
+	)
+
+	Configure[enum](NamingInsensitive(true))
+
+	for _, name := range []string{"DarkRed", "dark_red", "dark-red", "DARK_RED", "darkRed", "Dark Red"} {
+		// Act
+		actualEnum, err := Parse[enum](name)
+		require.NoError(t, err, name)
+
+		// Assert
+		assert.Equal(t, enum(1), actualEnum, name)
+	}
+
+	// Act
+	httpBlue, err := Parse[enum]("http-blue")
+	require.NoError(t, err)
+	skyBlue, err := Parse[enum]("sky_blue")
+	require.NoError(t, err)
+	_, err = Parse[enum]("dark_redd")
+
+	// Assert
+	assert.Equal(t, enum(2), httpBlue)
+	assert.Equal(t, enum(2), skyBlue)
+	assert.ErrorIs(t, err, ErrUnknownName)
+}
+
+func TestNamingInsensitive_OnConfigFieldTag_ThenParseAndStringWithConvention(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			_ struct{} `gnum:"naming_insensitive,string=kebab"`
+			Red,
+			DarkRed color_
+		}]
+	)
+
+	// Act
+	actualEnum, err := Parse[enum]("dark_red")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, enum(1), actualEnum)
+	assert.Equal(t, "dark-red", actualEnum.String())
+	assert.Equal(t, "DarkRed", actualEnum.Name())
+}
+
+func TestNamingInsensitive_OnNamesWithSameNormalizedName_ThenParseOnlyExactNames(t *testing.T) {
+	// Arrange
+	type (
+		code_ int
+		enum  = Enum[struct {
+			_        struct{} `gnum:"naming_insensitive"`
+			HTTPCode code_
+			HttpCode code_
+			GRPCCode code_
+		}]
+	)
+
+	// Act
+	err := Register[enum]()
+	require.NoError(t, err)
+	httpCode, httpCodeErr := Parse[enum]("HttpCode")
+	upperHTTPCode, upperHTTPCodeErr := Parse[enum]("HTTPCode")
+	_, snakeErr := Parse[enum]("http_code")
+	grpcCode, grpcCodeErr := Parse[enum]("grpc-code")
+
+	// Assert
+	assert.NoError(t, httpCodeErr)
+	assert.NoError(t, upperHTTPCodeErr)
+	assert.NoError(t, grpcCodeErr)
+	assert.Equal(t, enum(1), httpCode)
+	assert.Equal(t, enum(0), upperHTTPCode)
+	assert.Equal(t, enum(2), grpcCode)
+	assert.ErrorIs(t, snakeErr, ErrUnknownName)
+}
+
+func TestNamingInsensitive_OnGlobalOptionAndSameNormalizedNames_ThenNotPanic(t *testing.T) {
+	// Arrange
+	type (
+		code_ int
+		enum  = Enum[struct {
+			HTTPCode,
+			HttpCode code_
+		}]
+	)
+
+	SetOptions(NamingInsensitive(true))
+	defer SetOptions(NamingInsensitive(false))
+
+	// Act
+	// Assert
+	assert.NotPanics(t, func() {
+		assert.Equal(t, "HttpCode", enum(1).Name())
+	})
+}
+
+func TestNamingInsensitive_OnAliasesWithSameNormalizedName_ThenParseOnlyExactAliases(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			_       struct{} `gnum:"naming_insensitive"`
+			Red     color_   `gnum:"alias=dark_red"`
+			DarkRed color_   `gnum:"alias=crimson"`
+		}]
+	)
+
+	// Act
+	err := Register[enum]()
+	require.NoError(t, err)
+	red, redErr := Parse[enum]("dark_red")
+	darkRed, darkRedErr := Parse[enum]("DarkRed")
+	_, kebabErr := Parse[enum]("dark-red")
+	crimson, crimsonErr := Parse[enum]("CRIMSON")
+
+	// Assert
+	assert.NoError(t, redErr)
+	assert.NoError(t, darkRedErr)
+	assert.NoError(t, crimsonErr)
+	assert.Equal(t, enum(0), red)
+	assert.Equal(t, enum(1), darkRed)
+	assert.Equal(t, enum(1), crimson)
+	assert.ErrorIs(t, kebabErr, ErrUnknownName)
+}
+
+func TestStringConfigTag_OnUnknownConvention_ThenReturnProblem(t *testing.T) {
+	// Arrange
+	type (
+		color_ int
+		enum   = Enum[struct {
+			_   struct{} `gnum:"string=pascal"`
+			Red color_
+		}]
+	)
+
+	// Act
+	err := Register[enum]()
+
+	// Assert
+	assert.EqualError(t, err, "invalid enum option `string` value `pascal` - `string=pascal`")
+}
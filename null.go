@@ -0,0 +1,139 @@
+package gnum
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// Null is a nullable T, e.g., for an optional column or field.
+// SQL NULL, JSON null, an empty string and a missing JSON field are all an invalid Null.
+type Null[T Enumer[T]] struct {
+	Enum  T
+	Valid bool
+}
+
+// NewNull returns a valid Null of enum.
+func NewNull[T Enumer[T]](enum T) Null[T] {
+	return Null[T]{Enum: enum, Valid: true}
+}
+
+// register builds and caches the T metadata, it also marks Null as an enum field for DecodeStruct.
+func (n Null[T]) register() error {
+	return Register[T]()
+}
+
+// MarshalJSON implements the json.Marshaler interface, an invalid Null is marshaled as null.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Enum)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, null and an empty string are an invalid Null.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var enum T
+	if err := json.Unmarshal(data, &enum); err != nil {
+		return err
+	}
+
+	*n = NewNull(enum)
+	return nil
+}
+
+// MarshalText implements the TextMarshaler interface, an invalid Null is marshaled as an empty text.
+func (n Null[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	if marshaler, ok := any(n.Enum).(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	return []byte(n.Enum.Name()), nil
+}
+
+// UnmarshalText implements the TextUnmarshaler interface, an empty text is an invalid Null.
+func (n *Null[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*n = Null[T]{}
+		return nil
+	}
+
+	enum, err := Parse[T](string(text))
+	if err != nil {
+		return err
+	}
+
+	*n = NewNull(enum)
+	return nil
+}
+
+// Scan implements the sql.Scanner interface, NULL and an empty string are an invalid Null.
+// Otherwise, src is scanned by T if it implements sql.Scanner, or as an enum value or name.
+func (n *Null[T]) Scan(src any) error {
+	if src == nil || src == "" || isEmptyBytes(src) {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var enum T
+	if scanner, ok := any(&enum).(sql.Scanner); ok {
+		if err := scanner.Scan(src); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if enum, err = scanEnum[T](src); err != nil {
+			return err
+		}
+	}
+
+	*n = NewNull(enum)
+	return nil
+}
+
+// Value implements the driver.Valuer interface, an invalid Null is stored as NULL.
+// Otherwise, the enum is stored by T if it implements driver.Valuer, or as the enum name.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if valuer, ok := any(n.Enum).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return n.Enum.Name(), nil
+}
+
+func isEmptyBytes(src any) bool {
+	data, ok := src.([]byte)
+	return ok && len(data) == 0
+}
+
+// scanEnum scans src as an enum value or name, for a T that doesn't implement sql.Scanner.
+func scanEnum[T Enumer[T]](src any) (T, error) {
+	switch value := src.(type) {
+	case int64:
+		return FromValue[T](int(value))
+	case string:
+		return Parse[T](value)
+	case []byte:
+		return Parse[T](string(value))
+	default:
+		return -1, fmt.Errorf("can't scan `%v` of type `%T` into `%s`", src, src, Type[T]())
+	}
+}
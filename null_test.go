@@ -0,0 +1,152 @@
+package gnum
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	_ driver.Valuer    = Null[testFruit]{}
+	_ sql.Scanner      = new(Null[testFruit])
+	_ json.Marshaler   = Null[testFruit]{}
+	_ json.Unmarshaler = new(Null[testFruit])
+)
+
+type testBasket struct {
+	Fruit Null[testFruit] `json:"fruit"`
+}
+
+func TestNullMarshalJSON_OnValidAndInvalid_ThenReturnEnumOrNull(t *testing.T) {
+	// Arrange
+	// Act
+	validJSON, err := json.Marshal(testBasket{Fruit: NewNull(cherry)})
+	require.NoError(t, err)
+	invalidJSON, err := json.Marshal(testBasket{})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, `{"fruit":"Cherry"}`, string(validJSON))
+	assert.Equal(t, `{"fruit":null}`, string(invalidJSON))
+}
+
+func TestNullUnmarshalJSON_OnNullEmptyAndMissing_ThenReturnInvalid(t *testing.T) {
+	for _, data := range []string{`{"fruit":null}`, `{"fruit":""}`, `{}`} {
+		// Arrange
+		basket := testBasket{Fruit: NewNull(apple)}
+		if data == `{}` {
+			basket = testBasket{}
+		}
+
+		// Act
+		err := json.Unmarshal([]byte(data), &basket)
+		require.NoError(t, err, data)
+
+		// Assert
+		assert.Equal(t, Null[testFruit]{}, basket.Fruit, data)
+	}
+}
+
+func TestNullUnmarshalJSON_OnEnum_ThenReturnValid(t *testing.T) {
+	for _, data := range []string{`{"fruit":"Banana"}`, `{"fruit":1}`} {
+		// Arrange
+		var basket testBasket
+
+		// Act
+		err := json.Unmarshal([]byte(data), &basket)
+		require.NoError(t, err, data)
+
+		// Assert
+		assert.Equal(t, NewNull(banana), basket.Fruit, data)
+	}
+}
+
+func TestNullUnmarshalJSON_OnUnknownName_ThenReturnError(t *testing.T) {
+	// Arrange
+	var basket testBasket
+
+	// Act
+	err := json.Unmarshal([]byte(`{"fruit":"Bananna"}`), &basket)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownName)
+}
+
+func TestNullText_OnValidAndEmpty_ThenRoundTrip(t *testing.T) {
+	// Arrange
+	var actualNull Null[testFruit]
+
+	// Act
+	validText, err := NewNull(apple).MarshalText()
+	require.NoError(t, err)
+	invalidText, err := Null[testFruit]{}.MarshalText()
+	require.NoError(t, err)
+	err = actualNull.UnmarshalText(validText)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "Apple", string(validText))
+	assert.Empty(t, invalidText)
+	assert.Equal(t, NewNull(apple), actualNull)
+	assert.NoError(t, actualNull.UnmarshalText(invalidText))
+	assert.False(t, actualNull.Valid)
+}
+
+func TestNullScan_OnNullAndEmpty_ThenReturnInvalid(t *testing.T) {
+	for _, src := range []any{nil, "", []byte{}} {
+		// Arrange
+		actualNull := NewNull(apple)
+
+		// Act
+		err := actualNull.Scan(src)
+		require.NoError(t, err)
+
+		// Assert
+		assert.False(t, actualNull.Valid, src)
+	}
+}
+
+func TestNullScan_OnEnum_ThenReturnValid(t *testing.T) {
+	for _, src := range []any{int64(2), "Cherry", []byte("Cherry")} {
+		// Arrange
+		var actualNull Null[testFruit]
+
+		// Act
+		err := actualNull.Scan(src)
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, NewNull(cherry), actualNull, src)
+	}
+}
+
+func TestNullScan_OnFlagEnum_ThenParseFlags(t *testing.T) {
+	// Arrange
+	var actualNull Null[testPermission]
+
+	// Act
+	err := actualNull.Scan("Read|Write")
+	require.NoError(t, err)
+	actualValue, err := actualNull.Value()
+	require.NoError(t, err)
+
+	// Assert
+	assert.True(t, actualNull.Valid)
+	assert.Equal(t, "Read|Write", actualValue)
+}
+
+func TestNullValue_OnValidAndInvalid_ThenReturnEnumValueOrNil(t *testing.T) {
+	// Arrange
+	// Act
+	validValue, err := NewNull(banana).Value()
+	require.NoError(t, err)
+	invalidValue, err := Null[testFruit]{}.Value()
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, int64(1), validValue)
+	assert.Nil(t, invalidValue)
+}
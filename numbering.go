@@ -0,0 +1,8 @@
+package gnum
+
+// shiftNumbering sets whether the enum values are powers of two, i.e., `1 << iota`.
+func shiftNumbering(shift bool) Option {
+	return func(c *config) {
+		c.numbering.Shift = shift
+	}
+}
@@ -0,0 +1,119 @@
+package gnum
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnumNumbering_OnExplicitValue_ThenContinueFromValuePlusOne(t *testing.T) {
+	// Arrange
+	type (
+		code_ int
+		enum  = Enum[struct {
+			OK       code_
+			NotFound code_ `gnum:"value=10"`
+			Gone     code_
+			Teapot   code_ `gnum:"value=-5"`
+			Other    code_
+		}]
+	)
+
+	// Act
+	actualValues := Values[enum]()
+
+	// Assert
+	assert.Equal(t, []int{-5, -4, 0, 10, 11}, actualValues)
+	assert.Equal(t, []string{"Teapot", "Other", "OK", "NotFound", "Gone"}, Names[enum]())
+}
+
+func TestEnumNumbering_OnStartAndStep_ThenReturnLinearValues(t *testing.T) {
+	// Arrange
+	type (
+		level_ int
+		enum   = Enum[struct {
+			_     struct{} `gnum:"start=100,step=10"`
+			Debug level_
+			Info  level_
+			Warn  level_ `gnum:"value=1000"`
+			Error level_
+		}]
+	)
+
+	// Act
+	actualValues := Values[enum]()
+
+	// Assert
+	assert.Equal(t, []int{100, 110, 1000, 1010}, actualValues)
+}
+
+func TestEnumNumbering_OnShift_ThenReturnPowersOfTwo(t *testing.T) {
+	// Arrange
+	type (
+		feature_ int
+		enum     = Enum[struct {
+			_     struct{} `gnum:"shift,start=2"`
+			Fast  feature_
+			Small feature_
+			Safe  feature_ `gnum:"value=64"`
+			Cheap feature_
+		}]
+	)
+
+	// Act
+	actualValues := Values[enum]()
+
+	// Assert
+	assert.Equal(t, []int{4, 8, 64, 128}, actualValues)
+}
+
+func TestEnumNumbering_OnFlagEnumWithoutShift_ThenReturnLinearValues(t *testing.T) {
+	// Arrange
+	type (
+		feature_ int
+		flag     = FlagEnum[struct {
+			_     struct{} `gnum:"shift=false,start=1"`
+			Fast  feature_
+			Small feature_
+		}]
+	)
+
+	// Act
+	actualValues := Values[flag]()
+
+	// Assert
+	assert.Equal(t, []int{1, 2}, actualValues)
+}
+
+func TestEnumNumbering_OnShiftOverflow_ThenPanic(t *testing.T) {
+	// Arrange
+	type (
+		feature_ int
+		enum     = Enum[struct {
+			_    struct{} `gnum:"shift,start=70"`
+			Fast feature_
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		Values[enum]()
+	})
+}
+
+func TestEnumNumbering_OnZeroStep_ThenPanic(t *testing.T) {
+	// Arrange
+	type (
+		feature_ int
+		enum     = Enum[struct {
+			_    struct{} `gnum:"step=0"`
+			Fast feature_
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		Values[enum]()
+	})
+}
@@ -0,0 +1,30 @@
+package gnum
+
+import (
+	"fmt"
+)
+
+// registerer is implemented by Enum and FlagEnum to build their metadata eagerly,
+// and by Null to build the metadata of its enum.
+type registerer interface {
+	register() error
+}
+
+// Register builds and caches the T metadata immediately instead of on first use,
+// e.g., from the enum package init function, so a misconfigured enum fails at startup.
+// It returns all the problems of the T definition joined together.
+func Register[T Enumer[T]]() error {
+	enum, ok := any(T(0)).(registerer)
+	if !ok {
+		return fmt.Errorf("`%T` isn't a gnum enum", T(0))
+	}
+
+	return enum.register()
+}
+
+// MustRegister is like Register but panics if the T definition is invalid.
+func MustRegister[T Enumer[T]]() {
+	if err := Register[T](); err != nil {
+		panic(err.Error())
+	}
+}
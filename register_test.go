@@ -0,0 +1,115 @@
+package gnum
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"reflect"
+	"testing"
+)
+
+func TestRegister_OnValidEnum_ThenCacheMetadata(t *testing.T) {
+	// Arrange
+	type (
+		planet_ int
+		enum    = Enum[struct {
+			Mercury,
+			Venus planet_
+		}]
+	)
+
+	// Act
+	err := Register[enum]()
+
+	// Assert
+	require.NoError(t, err)
+	_, _, ok := cache.Lookup(reflect.TypeOf(enum(0)))
+	assert.True(t, ok)
+	assert.NoError(t, Register[enum]())
+	assert.Equal(t, []string{"Mercury", "Venus"}, Names[enum]())
+}
+
+func TestRegister_OnValidFlagEnum_ThenCacheMetadata(t *testing.T) {
+	// Arrange
+	type (
+		access_ int
+		enum    = FlagEnum[struct {
+			Read,
+			Write access_
+		}]
+	)
+
+	// Act
+	err := Register[enum]()
+
+	// Assert
+	require.NoError(t, err)
+	_, _, ok := cache.Lookup(reflect.TypeOf(enum(0)))
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 2}, Values[enum]())
+}
+
+func TestRegister_OnInvalidEnum_ThenReturnAllProblems(t *testing.T) {
+	// Arrange
+	type (
+		planet_ int
+		enum    = Enum[struct {
+			_       struct{} `gnum:"case_sensitive"`
+			Mercury planet_  `gnum:"nme=Hermes"`
+			Venus   planet_  `gnum:"name=Mars"`
+			Mars    planet_
+			Earth   planet_ `gnum:"value=1"`
+		}]
+	)
+
+	// Act
+	err := Register[enum]()
+
+	// Assert
+	assert.EqualError(
+		t,
+		err,
+		"unknown enum option `case_sensitive` - `case_sensitive`\n"+
+			"invalid gnum tag of `Mercury` field: unknown key `nme` - `nme=Hermes`\n"+
+			"duplicate enum name - `Mars`\n"+
+			"`Mars` and `Earth` have the same value")
+	_, _, ok := cache.Lookup(reflect.TypeOf(enum(0)))
+	assert.False(t, ok)
+}
+
+func TestRegister_OnCollidingAliases_ThenReturnAllProblems(t *testing.T) {
+	// Arrange
+	type (
+		planet_ int
+		enum    = Enum[struct {
+			Mercury planet_ `gnum:"alias=Venus|first"`
+			Venus   planet_ `gnum:"alias=first"`
+		}]
+	)
+
+	// Act
+	err := Register[enum]()
+
+	// Assert
+	assert.EqualError(
+		t,
+		err,
+		"`Venus` alias of `Mercury` is already an enum name\n"+
+			"`Mercury` and `Venus` have the same alias `first`")
+}
+
+func TestMustRegister_OnInvalidEnum_ThenPanic(t *testing.T) {
+	// Arrange
+	type (
+		planet_ int
+		enum    = Enum[struct {
+			Mercury planet_ `gnum:"name="`
+			Venus   planet_
+		}]
+	)
+
+	// Act
+	// Assert
+	assert.PanicsWithValue(t, "invalid gnum tag of `Mercury` field: enum name can't be empty - `name=`", func() {
+		MustRegister[enum]()
+	})
+}
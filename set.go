@@ -0,0 +1,260 @@
+package gnum
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+)
+
+// setTextSeparator separates the enum names of a text marshaled EnumSet.
+const setTextSeparator = ","
+
+// EnumSet is a set of T members, backed by a bitset over the T members sorted by value.
+// The zero value is an empty set ready to use. Like a Go map, copies of a non-empty set share its bitset,
+// so Add and Remove on a copy change the original as well, use Clone for an independent copy.
+type EnumSet[T Enumer[T]] struct {
+	words []uint64
+}
+
+// NewEnumSet returns an EnumSet[T] containing enums.
+func NewEnumSet[T Enumer[T]](enums ...T) EnumSet[T] {
+	var set EnumSet[T]
+	set.Add(enums...)
+
+	return set
+}
+
+// Add adds enums to s, it panics if one of the enums isn't part of the T mapping.
+// The bitset is allocated for all the T members on the first Add, so it's never reallocated afterwards.
+func (s *EnumSet[T]) Add(enums ...T) {
+	for _, enum := range enums {
+		s.addIndex(mustGetEnumIndex(enum))
+	}
+}
+
+// Remove removes enums from s.
+func (s *EnumSet[T]) Remove(enums ...T) {
+	for _, enum := range enums {
+		if index, ok := getEnumIndex(enum); ok {
+			s.removeIndex(index)
+		}
+	}
+}
+
+// Contains reports whether enum is in s.
+func (s EnumSet[T]) Contains(enum T) bool {
+	index, ok := getEnumIndex(enum)
+	return ok && s.containsIndex(index)
+}
+
+// Clone returns a copy of s that doesn't share its bitset.
+func (s EnumSet[T]) Clone() EnumSet[T] {
+	return EnumSet[T]{words: slices.Clone(s.words)}
+}
+
+// Union returns a new set with the members that are in s or in other.
+func (s EnumSet[T]) Union(other EnumSet[T]) EnumSet[T] {
+	return s.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+// Intersect returns a new set with the members that are in both s and other.
+func (s EnumSet[T]) Intersect(other EnumSet[T]) EnumSet[T] {
+	return s.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+// Difference returns a new set with the members that are in s but not in other.
+func (s EnumSet[T]) Difference(other EnumSet[T]) EnumSet[T] {
+	return s.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// Complement returns a new set with all the T members that aren't in s.
+func (s EnumSet[T]) Complement() EnumSet[T] {
+	membersCount := T.ValueList(-1).Len()
+	complement := s.combine(EnumSet[T]{}, func(a, _ uint64) uint64 { return ^a })
+	if membersCount%64 != 0 {
+		complement.words[len(complement.words)-1] &= 1<<(membersCount%64) - 1
+	}
+
+	return complement
+}
+
+// Len returns the number of members in s.
+func (s EnumSet[T]) Len() int {
+	count := 0
+	for _, word := range s.words {
+		count += bits.OnesCount64(word)
+	}
+
+	return count
+}
+
+// Enums returns the members of s sorted by the enum values.
+func (s EnumSet[T]) Enums() []T {
+	enums := make([]T, 0, s.Len())
+	s.Range(func(enum T) bool {
+		enums = append(enums, enum)
+		return true
+	})
+
+	return enums
+}
+
+// Range calls f for each member of s sorted by the enum values, until f returns false.
+func (s EnumSet[T]) Range(f func(enum T) bool) {
+	values := T.ValueList(-1).items
+	s.rangeIndexes(func(index int) bool {
+		return f(T(values[index]))
+	})
+}
+
+// String returns the members strings, e.g., "{Red, Blue}".
+func (s EnumSet[T]) String() string {
+	var enumStrings []string
+	s.Range(func(enum T) bool {
+		enumStrings = append(enumStrings, enum.String())
+		return true
+	})
+
+	return "{" + strings.Join(enumStrings, ", ") + "}"
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding s as an array of the members names.
+func (s EnumSet[T]) MarshalJSON() ([]byte, error) {
+	names := make([]string, 0, s.Len())
+	s.Range(func(enum T) bool {
+		names = append(names, enum.Name())
+		return true
+	})
+
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, decoding an array of the members names.
+func (s *EnumSet[T]) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+
+	return s.parseNames(names)
+}
+
+// MarshalText implements the TextMarshaler interface, encoding s as "," separated members names.
+func (s EnumSet[T]) MarshalText() ([]byte, error) {
+	names := make([]string, 0, s.Len())
+	s.Range(func(enum T) bool {
+		names = append(names, enum.Name())
+		return true
+	})
+
+	return []byte(strings.Join(names, setTextSeparator)), nil
+}
+
+// UnmarshalText implements the TextUnmarshaler interface, decoding "," separated members names.
+func (s *EnumSet[T]) UnmarshalText(text []byte) error {
+	var names []string
+	if strings.TrimSpace(string(text)) != "" {
+		names = strings.Split(string(text), setTextSeparator)
+	}
+
+	return s.parseNames(names)
+}
+
+// parseNames replaces s members with the parsed names.
+func (s *EnumSet[T]) parseNames(names []string) error {
+	var set EnumSet[T]
+	for _, name := range names {
+		enum, err := Parse[T](strings.TrimSpace(name))
+		if err != nil {
+			return err
+		}
+
+		set.Add(enum)
+	}
+
+	*s = set
+	return nil
+}
+
+// addIndex adds the member of index to s, allocating the bitset for all the T members if needed.
+func (s *EnumSet[T]) addIndex(index int) {
+	if s.words == nil {
+		s.words = make([]uint64, (T.ValueList(-1).Len()+63)/64)
+	}
+
+	s.words[index/64] |= 1 << (index % 64)
+}
+
+// removeIndex removes the member of index from s.
+func (s *EnumSet[T]) removeIndex(index int) {
+	if index/64 < len(s.words) {
+		s.words[index/64] &^= 1 << (index % 64)
+	}
+}
+
+// containsIndex reports whether the member of index is in s.
+func (s EnumSet[T]) containsIndex(index int) bool {
+	return index/64 < len(s.words) && s.words[index/64]&(1<<(index%64)) != 0
+}
+
+// rangeIndexes calls f for the index of each member of s in ascending order, until f returns false.
+func (s EnumSet[T]) rangeIndexes(f func(index int) bool) {
+	for wordIndex, word := range s.words {
+		for word != 0 {
+			if !f(wordIndex*64 + bits.TrailingZeros64(word)) {
+				return
+			}
+
+			word &= word - 1
+		}
+	}
+}
+
+// combine returns a new set sized for all the T members, applying op on each word of s and other.
+func (s EnumSet[T]) combine(other EnumSet[T], op func(a, b uint64) uint64) EnumSet[T] {
+	combined := EnumSet[T]{words: make([]uint64, (T.ValueList(-1).Len()+63)/64)}
+	for i := range combined.words {
+		var a, b uint64
+		if i < len(s.words) {
+			a = s.words[i]
+		}
+
+		if i < len(other.words) {
+			b = other.words[i]
+		}
+
+		combined.words[i] = op(a, b)
+	}
+
+	return combined
+}
+
+// indexer is implemented by Enum and FlagEnum to look up a value index by their metadata,
+// it's called on the zero value so the lookup doesn't box the enum.
+type indexer interface {
+	indexOf(value int) (int, bool)
+}
+
+// getEnumIndex returns the index of enum in the T members sorted by value, using the T metadata lookup
+// if T implements indexer, otherwise a binary search of the T values.
+func getEnumIndex[T Enumer[T]](enum T) (int, bool) {
+	if enumIndexer, ok := any(T(0)).(indexer); ok {
+		return enumIndexer.indexOf(int(enum))
+	}
+
+	values := T.ValueList(-1).items
+	return slices.BinarySearch(values, int(enum))
+}
+
+// mustGetEnumIndex returns the index of enum in the T members sorted by value,
+// it panics if enum isn't part of the T mapping.
+func mustGetEnumIndex[T Enumer[T]](enum T) int {
+	index, ok := getEnumIndex(enum)
+	if !ok {
+		panic(fmt.Sprintf(enumValueNotExistsErrorFormat, enum, enum))
+	}
+
+	return index
+}
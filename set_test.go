@@ -0,0 +1,219 @@
+package gnum
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestEnumSetAdd_OnMultipleEnums_ThenContainsEnums(t *testing.T) {
+	// Arrange
+	var set EnumSet[testAnimal]
+
+	// Act
+	set.Add(cow, chicken, cow)
+
+	// Assert
+	assert.True(t, set.Contains(cow))
+	assert.True(t, set.Contains(chicken))
+	assert.False(t, set.Contains(dog))
+	assert.False(t, set.Contains(testAnimal(10)))
+	assert.Equal(t, 2, set.Len())
+}
+
+func TestEnumSetAdd_OnCopy_ThenOriginalChanged(t *testing.T) {
+	// Arrange
+	set := NewEnumSet(dog)
+	setCopy := set
+
+	// Act
+	setCopy.Add(cow)
+	setCopy.Remove(dog)
+
+	// Assert
+	assert.Equal(t, []testAnimal{cow}, set.Enums())
+	assert.Equal(t, []testAnimal{cow}, setCopy.Enums())
+}
+
+func TestEnumSetClone_OnAddAndRemove_ThenOriginalUnchanged(t *testing.T) {
+	// Arrange
+	set := NewEnumSet(dog)
+	setClone := set.Clone()
+
+	// Act
+	setClone.Add(cow)
+	setClone.Remove(dog)
+
+	// Assert
+	assert.Equal(t, []testAnimal{dog}, set.Enums())
+	assert.Equal(t, []testAnimal{cow}, setClone.Enums())
+}
+
+func TestEnumSetAdd_OnNonEmptySet_ThenNotAllocate(t *testing.T) {
+	// Arrange
+	set := NewEnumSet(dog)
+
+	// Act
+	allocs := testing.AllocsPerRun(100, func() {
+		set.Add(cow)
+		set.Remove(cow)
+	})
+
+	// Assert
+	assert.Zero(t, allocs)
+}
+
+func TestEnumSetAdd_OnEnumNotRegisteredInConfig_ThenPanic(t *testing.T) {
+	// Arrange
+	var set EnumSet[testAnimal]
+
+	// Act
+	// Assert
+	assert.Panics(t, func() {
+		set.Add(testAnimal(10))
+	})
+}
+
+func TestEnumSetRemove_OnExistingEnum_ThenNotContainsEnum(t *testing.T) {
+	// Arrange
+	set := NewEnumSet(dog, cat)
+
+	// Act
+	set.Remove(dog, chicken)
+
+	// Assert
+	assert.Equal(t, []testAnimal{cat}, set.Enums())
+}
+
+func TestEnumSetEnums_OnUnorderedEnums_ThenReturnEnumsSortedByValue(t *testing.T) {
+	// Arrange
+	set := NewEnumSet(cow, dog, chicken)
+
+	// Act
+	actualEnums := set.Enums()
+
+	// Assert
+	assert.Equal(t, []testAnimal{chicken, dog, cow}, actualEnums)
+}
+
+func TestEnumSetAlgebra_OnTwoSets_ThenReturnNewSets(t *testing.T) {
+	// Arrange
+	a := NewEnumSet(chicken, dog, cat)
+	b := NewEnumSet(cat, cow)
+
+	// Act
+	union := a.Union(b)
+	intersection := a.Intersect(b)
+	difference := a.Difference(b)
+	complement := b.Complement()
+
+	// Assert
+	assert.Equal(t, []testAnimal{chicken, dog, cat, cow}, union.Enums())
+	assert.Equal(t, []testAnimal{cat}, intersection.Enums())
+	assert.Equal(t, []testAnimal{chicken, dog}, difference.Enums())
+	assert.Equal(t, []testAnimal{chicken, dog}, complement.Enums())
+	assert.Equal(t, []testAnimal{chicken, dog, cat}, a.Enums())
+}
+
+func TestEnumSetComplement_OnEmptySet_ThenReturnAllEnums(t *testing.T) {
+	// Arrange
+	var set EnumSet[testAnimal]
+
+	// Act
+	actualSet := set.Complement()
+
+	// Assert
+	assert.Equal(t, Enums[testAnimal](), actualSet.Enums())
+	assert.Equal(t, 4, actualSet.Len())
+}
+
+func TestEnumSetRange_OnStop_ThenStopIterating(t *testing.T) {
+	// Arrange
+	set := NewEnumSet(chicken, dog, cat)
+	var actualEnums []testAnimal
+
+	// Act
+	set.Range(func(enum testAnimal) bool {
+		actualEnums = append(actualEnums, enum)
+		return enum != dog
+	})
+
+	// Assert
+	assert.Equal(t, []testAnimal{chicken, dog}, actualEnums)
+}
+
+func TestEnumSetString_OnMultipleEnums_ThenReturnStrings(t *testing.T) {
+	// Arrange
+	set := NewEnumSet(cat, dog)
+
+	// Act
+	actualString := set.String()
+
+	// Assert
+	assert.Equal(t, "{Dog, Cat}", actualString)
+}
+
+func TestEnumSetMarshalJSON_OnJsonMarshalAndUnmarshal_ThenReturnSameSet(t *testing.T) {
+	// Arrange
+	expected := struct{ Animals EnumSet[testAnimal] }{NewEnumSet(cow, chicken)}
+	actual := struct{ Animals EnumSet[testAnimal] }{NewEnumSet(dog)}
+
+	// Act
+	actualJsonBytes, err := json.Marshal(expected)
+	require.NoError(t, err)
+	err = json.Unmarshal(actualJsonBytes, &actual)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "{\"Animals\":[\"Chic\\tken\",\"Cow\"]}", string(actualJsonBytes))
+	assert.Equal(t, expected.Animals.Enums(), actual.Animals.Enums())
+}
+
+func TestEnumSetUnmarshalJSON_OnNonExistingEnumName_ThenReturnParseError(t *testing.T) {
+	// Arrange
+	var set EnumSet[testAnimal]
+
+	// Act
+	err := json.Unmarshal([]byte("[\"Dog\",\"Cot\"]"), &set)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownName)
+}
+
+func TestEnumSetMarshalText_OnMarshalAndUnmarshal_ThenReturnSameSet(t *testing.T) {
+	// Arrange
+	expected := NewEnumSet(cat, dog)
+	var actual EnumSet[testAnimal]
+
+	// Act
+	actualText, err := expected.MarshalText()
+	require.NoError(t, err)
+	err = actual.UnmarshalText(actualText)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "Dog,Cat", string(actualText))
+	assert.Equal(t, expected.Enums(), actual.Enums())
+}
+
+func TestEnumSetAdd_OnMoreThan64Members_ThenContainsAllEnums(t *testing.T) {
+	// Arrange
+	type (
+		bit_ int
+		enum = Enum[struct {
+			B0, B1, B2, B3, B4, B5, B6, B7, B8, B9, B10, B11, B12, B13, B14, B15,
+			B16, B17, B18, B19, B20, B21, B22, B23, B24, B25, B26, B27, B28, B29, B30, B31,
+			B32, B33, B34, B35, B36, B37, B38, B39, B40, B41, B42, B43, B44, B45, B46, B47,
+			B48, B49, B50, B51, B52, B53, B54, B55, B56, B57, B58, B59, B60, B61, B62, B63,
+			B64, B65 bit_
+		}]
+	)
+
+	// Act
+	set := NewEnumSet[enum](0, 64, 65)
+
+	// Assert
+	assert.Equal(t, []enum{0, 64, 65}, set.Enums())
+	assert.Equal(t, 63, set.Complement().Len())
+}
@@ -0,0 +1,67 @@
+package gnum
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// Value implements the driver.Valuer interface for T.
+// Depending on the SQLStorage option, it returns the enum value as int64 or the enum name.
+func (e Enum[T]) Value() (driver.Value, error) {
+	name, err := e.TryName()
+	if err != nil {
+		return nil, err
+	}
+
+	if e.getConfig().config.sqlStorage == SQLStorageName {
+		return name, nil
+	}
+
+	return int64(e), nil
+}
+
+// Scan implements the sql.Scanner interface for T.
+// It accepts the enum value as int64 and the enum name or value as string or []byte,
+// regardless of the SQLStorage option.
+func (e *Enum[T]) Scan(src any) error {
+	var (
+		enum Enum[T]
+		err  error
+	)
+	switch value := src.(type) {
+	case int64:
+		enum, err = FromValue[Enum[T]](int(value))
+	case string:
+		enum, err = e.scanText(value)
+	case []byte:
+		enum, err = e.scanText(string(value))
+	case nil:
+		return fmt.Errorf("can't scan NULL into `%T`", *e)
+	default:
+		return fmt.Errorf("can't scan `%v` of type `%T` into `%T`", src, src, *e)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*e = enum
+	return nil
+}
+
+// scanText parses text as an enum name, falling back to an enum value
+// since some drivers return numeric columns as text.
+func (e Enum[T]) scanText(text string) (Enum[T], error) {
+	enum, err := e.Parse(text)
+	if err == nil {
+		return enum, nil
+	}
+
+	value, atoiErr := strconv.Atoi(text)
+	if atoiErr != nil {
+		return -1, err
+	}
+
+	return FromValue[Enum[T]](value)
+}
@@ -0,0 +1,107 @@
+package gnum
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type (
+	testFruit = Enum[struct {
+		Apple,
+		Banana,
+		Cherry fruit
+	}]
+	fruit int
+)
+
+const (
+	apple testFruit = iota
+	banana
+	cherry
+)
+
+var (
+	_ driver.Valuer = apple
+	_ sql.Scanner   = new(testFruit)
+)
+
+func TestReceiverValue_OnDefaultConfig_ThenReturnInt64(t *testing.T) {
+	// Arrange
+	// Act
+	actualValue, err := cat.Value()
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, int64(1), actualValue)
+}
+
+func TestReceiverValue_OnGlobalNameStorage_ThenReturnName(t *testing.T) {
+	// Arrange
+	SetOptions(SQLStorage(SQLStorageName))
+	defer SetOptions(SQLStorage(SQLStorageValue))
+
+	// Act
+	actualValue, err := cat.Value()
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "Cat", actualValue)
+}
+
+func TestReceiverValue_OnEnumNameStorage_ThenReturnNameOnlyForEnum(t *testing.T) {
+	// Arrange
+	Configure[testFruit](SQLStorage(SQLStorageName))
+
+	// Act
+	actualFruitValue, err := banana.Value()
+	require.NoError(t, err)
+	actualAnimalValue, err := cat.Value()
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "Banana", actualFruitValue)
+	assert.Equal(t, int64(1), actualAnimalValue)
+}
+
+func TestReceiverValue_OnEnumNotRegisteredInConfig_ThenReturnError(t *testing.T) {
+	// Arrange
+	const notRegisteredEnum testAnimal = 10
+
+	// Act
+	_, err := notRegisteredEnum.Value()
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestReceiverScan_OnSupportedSources_ThenReturnEnum(t *testing.T) {
+	for _, src := range []any{int64(2), "Cow", []byte("Cow"), "2", []byte("2")} {
+		// Arrange
+		actualEnum := new(testAnimal)
+
+		// Act
+		err := actualEnum.Scan(src)
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, cow, *actualEnum)
+	}
+}
+
+func TestReceiverScan_OnUnknownSources_ThenReturnError(t *testing.T) {
+	for _, src := range []any{int64(10), "nop", []byte("10"), nil, 1.5} {
+		// Arrange
+		actualEnum := new(testAnimal)
+		*actualEnum = dog
+
+		// Act
+		err := actualEnum.Scan(src)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, dog, *actualEnum)
+	}
+}
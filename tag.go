@@ -1,91 +1,114 @@
 package gnum
 
 import (
-	"fmt"
+	"github.com/joelboim/gnum/infra"
 	"reflect"
-	"regexp"
-	"strconv"
 )
 
-var (
-	enumTagValuePattern = regexp.MustCompile(`value=(?P<value>-?\d+)(,|$)`)
-	enumTagNamePattern  = regexp.MustCompile(`name=(?P<name>.+)(,|$)`)
-)
+// enumConfigFieldName is the name of the definition field whose tag holds the enum options,
+// e.g., `_ struct{} gnum:"case_insensitive,sql=name"`.
+const enumConfigFieldName = "_"
 
-type enumTag struct {
-	Name  *string
-	Value *int
-}
+type enumTag = infra.EnumTag
 
-func newEnumTag(field reflect.StructField) *enumTag {
+// newEnumTag returns the parsed tag of an enum declaration field,
+// or nil if the field has no tag or its tag is invalid, in which case the problem is added to problems.
+func newEnumTag(field reflect.StructField, problems *enumProblems) *enumTag {
 	rawFieldTag, ok := field.Tag.Lookup("gnum")
 	if !ok {
 		return nil
 	}
 
-	enumTag := &enumTag{
-		Name:  getEnumName(rawFieldTag),
-		Value: getEnumValue(rawFieldTag),
-	}
-
-	if enumTag.Name == nil &&
-		enumTag.Value == nil {
-		panic(fmt.Sprintf("enum definition not found - `%s`", rawFieldTag))
+	enumTag, err := infra.ParseEnumTag(rawFieldTag)
+	if err != nil {
+		problems.add("invalid gnum tag of `%s` field: %s", field.Name, err)
+		return nil
 	}
 
 	return enumTag
-
 }
 
-func getEnumName(rawFieldTag string) *string {
-	enumName := getTagValue(
-		enumTagNamePattern,
-		rawFieldTag)
-	if enumName == nil {
-		return nil
-	}
+// enumConfigTagKeys maps each key of the enum config tag to the Option it sets,
+// the values are validated by infra.EnumConfigTagKeys first.
+var enumConfigTagKeys = map[string]func(value string) Option{
+	"case_insensitive": func(value string) Option {
+		caseInsensitive, _ := infra.ParseBoolTagValue(value)
+		return CaseInsensitive(caseInsensitive)
+	},
+	"strict_string": func(value string) Option {
+		strictString, _ := infra.ParseBoolTagValue(value)
+		return StrictString(strictString)
+	},
+	"json": func(value string) Option {
+		return JSONFormat(jsonFormatModes[value])
+	},
+	"naming_insensitive": func(value string) Option {
+		namingInsensitive, _ := infra.ParseBoolTagValue(value)
+		return NamingInsensitive(namingInsensitive)
+	},
+	"string": func(value string) Option {
+		return StringCallback(namingConventions[value])
+	},
+	"type": func(value string) Option {
+		return TypeName(value)
+	},
+	"sql": func(value string) Option {
+		if value == "name" {
+			return SQLStorage(SQLStorageName)
+		}
+
+		return SQLStorage(SQLStorageValue)
+	},
+}
 
-	if *enumName == "" {
-		panic(fmt.Sprintf("enum name can't be empty - `%s`", rawFieldTag))
+func init() {
+	for key, setNumbering := range infra.NumberingTagKeys {
+		enumConfigTagKeys[key] = func(value string) Option {
+			return func(c *config) {
+				setNumbering(&c.numbering, value)
+			}
+		}
 	}
+}
 
-	return enumName
-
+// isEnumConfigField reports whether field is the enum config field rather than an enum declaration.
+func isEnumConfigField(field reflect.StructField) bool {
+	return field.Name == enumConfigFieldName
 }
 
-func getEnumValue(rawFieldTag string) *int {
-	enumValue := getTagValue(
-		enumTagValuePattern,
-		rawFieldTag)
-	if enumValue == nil {
+// newEnumConfigTagOptions returns the options declared by the enum config field tag,
+// invalid options are added to problems.
+func newEnumConfigTagOptions(field reflect.StructField, problems *enumProblems) []Option {
+	rawFieldTag, ok := field.Tag.Lookup("gnum")
+	if !ok {
 		return nil
 	}
 
-	if *enumValue == "" {
-		panic(fmt.Sprintf("enum value can't be empty - `%s`", rawFieldTag))
-	}
-
-	enumValueInt, err := strconv.Atoi(*enumValue)
+	tagOptions, err := infra.ParseEnumConfigTag(rawFieldTag)
 	if err != nil {
-		panic(err)
-	}
-
-	return &enumValueInt
-}
-
-func getTagValue(pattern *regexp.Regexp, rawFieldTag string) *string {
-	submatches := pattern.FindAllStringSubmatch(rawFieldTag, -1)
-	if len(submatches) == 0 {
+		problems.add("invalid gnum tag of `%s` field: %s", field.Name, err)
 		return nil
 	}
 
-	if len(submatches) > 1 {
-		panic(
-			fmt.Sprintf(
-				"invalid number of enum %ss - `%s`",
-				pattern.SubexpNames()[0],
-				rawFieldTag))
+	var options []Option
+	for _, tagOption := range tagOptions {
+		isValid, ok := infra.EnumConfigTagKeys[tagOption.Key]
+		if !ok {
+			problems.add("unknown enum option `%s` - `%s`", tagOption.Key, rawFieldTag)
+			continue
+		}
+
+		if !isValid(tagOption.Value) {
+			problems.add(
+				"invalid enum option `%s` value `%s` - `%s`",
+				tagOption.Key,
+				tagOption.Value,
+				rawFieldTag)
+			continue
+		}
+
+		options = append(options, enumConfigTagKeys[tagOption.Key](tagOption.Value))
 	}
 
-	return &submatches[0][1]
+	return options
 }
@@ -0,0 +1,31 @@
+package gnum
+
+import (
+	"github.com/joelboim/gnum/infra"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnumConfigTagKeys_OnEachValidatedKey_ThenHasOption(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	for key := range infra.EnumConfigTagKeys {
+		assert.Contains(t, enumConfigTagKeys, key)
+	}
+
+	assert.Len(t, enumConfigTagKeys, len(infra.EnumConfigTagKeys))
+}
+
+func TestEnumConfigTagKeys_OnEachModeAndConvention_ThenValid(t *testing.T) {
+	// Arrange
+	// Act
+	// Assert
+	for mode := range jsonFormatModes {
+		assert.True(t, infra.EnumConfigTagKeys["json"](mode), mode)
+	}
+
+	for convention := range namingConventions {
+		assert.True(t, infra.EnumConfigTagKeys["string"](convention), convention)
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/joelboim/gnum/yamlgnum
+
+go 1.23.0
+
+require (
+	github.com/joelboim/gnum v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.9.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/kr/pretty v0.3.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/exp v0.0.0-20241009180824-f66d83c29e7c // indirect
+	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
+)
+
+replace github.com/joelboim/gnum => ../
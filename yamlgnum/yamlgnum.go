@@ -0,0 +1,156 @@
+// Package yamlgnum adds gopkg.in/yaml.v3 support to gnum enums, so the gnum package stays dependency free.
+// Decoding errors point to the line and column of the enum in the document.
+package yamlgnum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/joelboim/gnum"
+	"gopkg.in/yaml.v3"
+)
+
+// Enum wraps a gnum enum to implement yaml.Marshaler and yaml.Unmarshaler,
+// e.g., a config field `Color yamlgnum.Enum[Color]`.
+type Enum[T gnum.Enumer[T]] struct {
+	Enum T
+}
+
+// Error is a decoding error of an enum, at the line and column of its node.
+type Error struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+// Error returns the position and the underline error, e.g., "line 3, column 8: `Bleu` isn't part of [Red, Blue]".
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Err)
+}
+
+// Unwrap returns the underline error, e.g., a *gnum.ParseError.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// MarshalYAML implements the yaml.Marshaler interface, see Encode.
+func (e Enum[T]) MarshalYAML() (any, error) {
+	return Encode(e.Enum)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface, see Decode.
+// A null node that isn't an enum name leaves e unchanged, the same as a JSON null.
+// yaml.v3 doesn't call UnmarshalYAML on null scalars of a document, so members named like a null
+// (e.g., `Null`) have to be quoted there.
+func (e *Enum[T]) UnmarshalYAML(node *yaml.Node) error {
+	enum, err := Decode[T](node)
+	if err != nil {
+		if isNull(node) {
+			return nil
+		}
+
+		return err
+	}
+
+	e.Enum = enum
+	return nil
+}
+
+// Encode returns the yaml node of enum, represented the same as its JSON, e.g.,
+// the enum name by default or the enum value if gnum.JSONFormat(gnum.JSONFormatNumber) is set.
+func Encode[T gnum.Enumer[T]](enum T) (*yaml.Node, error) {
+	data, err := json.Marshal(enum)
+	if err != nil {
+		var marshalerError *json.MarshalerError
+		if errors.As(err, &marshalerError) {
+			return nil, marshalerError.Err
+		}
+
+		return nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) == 0 {
+		return nil, fmt.Errorf("can't encode `%s` as yaml, its JSON is an empty document", gnum.Type[T]())
+	}
+
+	node := document.Content[0]
+	resetStyle(node)
+	return node, nil
+}
+
+// Decode returns the enum of node, decoded by the same rules as its JSON, e.g., an enum name, alias,
+// string or value. A scalar is decoded from its text as a JSON string first, so names YAML would resolve
+// to another type (e.g., `True`, `Null` or `.inf`) are kept as written, and only an int scalar that isn't
+// a name is decoded as a JSON number. A null node that isn't a name has no enum, so it's a failure
+// like an invalid enum. A failure is returned as an *Error with the node position.
+func Decode[T gnum.Enumer[T]](node *yaml.Node) (T, error) {
+	enum, err := decode[T](node)
+	if err != nil {
+		return -1, &Error{Line: node.Line, Column: node.Column, Err: err}
+	}
+
+	return enum, nil
+}
+
+func decode[T gnum.Enumer[T]](node *yaml.Node) (T, error) {
+	if node.Kind != yaml.ScalarNode {
+		var raw any
+		if err := node.Decode(&raw); err != nil {
+			return -1, err
+		}
+
+		return unmarshalJSON[T](raw)
+	}
+
+	enum, err := unmarshalJSON[T](node.Value)
+	if err == nil {
+		return enum, nil
+	}
+
+	switch node.ShortTag() {
+	case "!!null":
+		return -1, fmt.Errorf("can't decode null into `%s`", gnum.Type[T]())
+	case "!!int":
+		var value int
+		if err := node.Decode(&value); err != nil {
+			return -1, err
+		}
+
+		return unmarshalJSON[T](value)
+	default:
+		return -1, err
+	}
+}
+
+// unmarshalJSON returns the enum of the JSON representation of raw.
+func unmarshalJSON[T gnum.Enumer[T]](raw any) (T, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return -1, err
+	}
+
+	var enum T
+	if err := json.Unmarshal(data, &enum); err != nil {
+		return -1, err
+	}
+
+	return enum, nil
+}
+
+// isNull reports whether node is a null scalar, e.g., "~" or "null".
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null"
+}
+
+// resetStyle sets the default style of node and its content, instead of the JSON quoted and flow styles.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
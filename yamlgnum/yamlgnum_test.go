@@ -0,0 +1,236 @@
+package yamlgnum
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/joelboim/gnum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+type (
+	testColor = gnum.Enum[struct {
+		Red   color `gnum:"alias=crimson"`
+		Green color
+		Blue  color
+	}]
+	color int
+
+	testPermission = gnum.FlagEnum[struct {
+		Read,
+		Write permission
+	}]
+	permission int
+)
+
+const (
+	red testColor = iota
+	green
+	blue
+)
+
+type testConfig struct {
+	Color       Enum[testColor]      `yaml:"color"`
+	Permissions Enum[testPermission] `yaml:"permissions"`
+}
+
+func TestUnmarshalYAML_OnNamesAndValues_ThenReturnEnums(t *testing.T) {
+	for _, document := range []string{
+		"color: Blue\npermissions: Read|Write\n",
+		"color: 2\npermissions: Read|Write\n",
+		"color: \"2\"\npermissions: Read|Write\n",
+		"color: {name: Blue, value: 2}\npermissions: Read|Write\n",
+	} {
+		// Arrange
+		var config testConfig
+
+		// Act
+		err := yaml.Unmarshal([]byte(document), &config)
+		require.NoError(t, err, document)
+
+		// Assert
+		assert.Equal(t, blue, config.Color.Enum, document)
+		assert.Equal(t, testPermission(3), config.Permissions.Enum, document)
+	}
+}
+
+func TestUnmarshalYAML_OnValueOfEnumWithoutJSONValues_ThenReturnJSONError(t *testing.T) {
+	// Arrange
+	var config testConfig
+	jsonErr := json.Unmarshal([]byte("3"), new(testPermission))
+
+	// Act
+	err := yaml.Unmarshal([]byte("permissions: 3\n"), &config)
+
+	// Assert
+	require.Error(t, jsonErr)
+	assert.EqualError(t, err, "line 1, column 14: "+jsonErr.Error())
+}
+
+func TestUnmarshalYAML_OnNull_ThenKeepEnum(t *testing.T) {
+	// Arrange
+	config := testConfig{Color: Enum[testColor]{blue}}
+	var node yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte("~"), &node))
+
+	// Act
+	err := yaml.Unmarshal([]byte("color: ~\n"), &config)
+	require.NoError(t, err)
+	unmarshalErr := config.Color.UnmarshalYAML(node.Content[0])
+
+	// Assert
+	assert.NoError(t, unmarshalErr)
+	assert.Equal(t, blue, config.Color.Enum)
+}
+
+func TestDecode_OnNull_ThenReturnPosition(t *testing.T) {
+	// Arrange
+	var document yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte("color: null\n"), &document))
+
+	// Act
+	_, err := Decode[testColor](document.Content[0].Content[1])
+
+	// Assert
+	assert.EqualError(t, err, "line 1, column 8: can't decode null into `color`")
+}
+
+func TestDecode_OnNamesOfOtherYAMLTypes_ThenReturnEnums(t *testing.T) {
+	// Arrange
+	type (
+		literal_ int
+		literal  = gnum.Enum[struct {
+			True,
+			False,
+			Null literal_
+			Inf   literal_ `gnum:"name=.inf"`
+			Octal literal_ `gnum:"name=0o10"`
+		}]
+	)
+
+	for document, expectedEnum := range map[string]literal{
+		"True":  0,
+		"False": 1,
+		"Null":  2,
+		".inf":  3,
+		"0o10":  4,
+	} {
+		var node yaml.Node
+		require.NoError(t, yaml.Unmarshal([]byte(document), &node))
+
+		// Act
+		actualEnum, err := Decode[literal](node.Content[0])
+		require.NoError(t, err, document)
+
+		// Assert
+		assert.Equal(t, expectedEnum, actualEnum, document)
+	}
+}
+
+func TestUnmarshalYAML_OnIntOfOtherBase_ThenReturnEnumOfValue(t *testing.T) {
+	// Arrange
+	type (
+		mode_ int
+		mode  = gnum.Enum[struct {
+			Exec  mode_ `gnum:"value=1"`
+			Write mode_ `gnum:"value=2"`
+			Read  mode_ `gnum:"value=8"`
+		}]
+	)
+	var enum Enum[mode]
+
+	// Act
+	err := yaml.Unmarshal([]byte("0o10"), &enum)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, mode(8), enum.Enum)
+}
+
+func TestUnmarshalYAML_OnUnknownNameOfOtherYAMLType_ThenReturnParseError(t *testing.T) {
+	// Arrange
+	var config testConfig
+
+	// Act
+	err := yaml.Unmarshal([]byte("color: .inf\n"), &config)
+
+	// Assert
+	assert.ErrorIs(t, err, gnum.ErrUnknownName)
+	assert.ErrorContains(t, err, "line 1, column 8: `.inf` isn't part of [Red, Green, Blue]")
+}
+
+func TestUnmarshalYAML_OnAlias_ThenReturnEnum(t *testing.T) {
+	// Arrange
+	var config testConfig
+
+	// Act
+	err := yaml.Unmarshal([]byte("color: crimson\n"), &config)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, red, config.Color.Enum)
+}
+
+func TestUnmarshalYAML_OnUnknownName_ThenReturnPositionAndSuggestion(t *testing.T) {
+	// Arrange
+	var config testConfig
+
+	// Act
+	err := yaml.Unmarshal([]byte("permissions: Read\ncolor:   Bleu\n"), &config)
+
+	// Assert
+	assert.EqualError(t, err, "line 2, column 10: `Bleu` isn't part of [Red, Green, Blue], did you mean `Blue`?")
+
+	var yamlError *Error
+	require.True(t, errors.As(err, &yamlError))
+	assert.Equal(t, 2, yamlError.Line)
+	assert.Equal(t, 10, yamlError.Column)
+	assert.ErrorIs(t, err, gnum.ErrUnknownName)
+}
+
+func TestUnmarshalYAML_OnUnknownValue_ThenReturnPosition(t *testing.T) {
+	// Arrange
+	var config testConfig
+
+	// Act
+	err := yaml.Unmarshal([]byte("color: 7\n"), &config)
+
+	// Assert
+	assert.ErrorContains(t, err, "line 1, column 8: `7` isn't part of")
+}
+
+func TestMarshalYAML_OnEnums_ThenReturnNames(t *testing.T) {
+	// Arrange
+	config := testConfig{Color: Enum[testColor]{green}, Permissions: Enum[testPermission]{3}}
+
+	// Act
+	actualDocument, err := yaml.Marshal(config)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "color: Green\npermissions: Read|Write\n", string(actualDocument))
+}
+
+func TestMarshalYAML_OnNumberJSONFormat_ThenReturnValue(t *testing.T) {
+	// Arrange
+	gnum.Configure[testColor](gnum.JSONFormat(gnum.JSONFormatNumber))
+	defer gnum.Configure[testColor](gnum.JSONFormat(gnum.JSONFormatName))
+
+	// Act
+	actualDocument, err := yaml.Marshal(testConfig{Color: Enum[testColor]{blue}, Permissions: Enum[testPermission]{1}})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "color: 2\npermissions: Read\n", string(actualDocument))
+}
+
+func TestMarshalYAML_OnUnknownValue_ThenReturnError(t *testing.T) {
+	// Arrange
+	// Act
+	_, err := yaml.Marshal(testConfig{Color: Enum[testColor]{7}, Permissions: Enum[testPermission]{1}})
+
+	// Assert
+	assert.Error(t, err)
+}